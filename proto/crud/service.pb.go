@@ -9,8 +9,11 @@
 package crud
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -22,13 +25,20 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Fields 1 and 2 were the name and age of the original placeholder request.
+// Here and below, string max_len limits are the widths of the columns the
+// fields are stored in.
 type CreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Age  int32  `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	NamaBarang string `protobuf:"bytes,3,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	FotoBarang string `protobuf:"bytes,4,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
+	Harga      int32  `protobuf:"varint,5,opt,name=harga,proto3" json:"harga,omitempty"`
+	IdKategori int64  `protobuf:"varint,6,opt,name=id_kategori,json=idKategori,proto3" json:"id_kategori,omitempty"`
+	IdJenis    int64  `protobuf:"varint,7,opt,name=id_jenis,json=idJenis,proto3" json:"id_jenis,omitempty"`
+	IdMaterial int64  `protobuf:"varint,8,opt,name=id_material,json=idMaterial,proto3" json:"id_material,omitempty"`
 }
 
 func (x *CreateRequest) Reset() {
@@ -63,16 +73,44 @@ func (*CreateRequest) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *CreateRequest) GetName() string {
+func (x *CreateRequest) GetNamaBarang() string {
 	if x != nil {
-		return x.Name
+		return x.NamaBarang
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetFotoBarang() string {
+	if x != nil {
+		return x.FotoBarang
 	}
 	return ""
 }
 
-func (x *CreateRequest) GetAge() int32 {
+func (x *CreateRequest) GetHarga() int32 {
+	if x != nil {
+		return x.Harga
+	}
+	return 0
+}
+
+func (x *CreateRequest) GetIdKategori() int64 {
+	if x != nil {
+		return x.IdKategori
+	}
+	return 0
+}
+
+func (x *CreateRequest) GetIdJenis() int64 {
+	if x != nil {
+		return x.IdJenis
+	}
+	return 0
+}
+
+func (x *CreateRequest) GetIdMaterial() int64 {
 	if x != nil {
-		return x.Age
+		return x.IdMaterial
 	}
 	return 0
 }
@@ -82,8 +120,9 @@ type CreateResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success  bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	IdBarang int64  `protobuf:"varint,3,opt,name=id_barang,json=idBarang,proto3" json:"id_barang,omitempty"`
 }
 
 func (x *CreateResponse) Reset() {
@@ -132,14 +171,32 @@ func (x *CreateResponse) GetMessage() string {
 	return ""
 }
 
-type ReadAllRequest struct {
+func (x *CreateResponse) GetIdBarang() int64 {
+	if x != nil {
+		return x.IdBarang
+	}
+	return 0
+}
+
+type Barang struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	IdBarang     int64  `protobuf:"varint,1,opt,name=id_barang,json=idBarang,proto3" json:"id_barang,omitempty"`
+	NamaBarang   string `protobuf:"bytes,2,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	FotoBarang   string `protobuf:"bytes,3,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
+	Harga        int32  `protobuf:"varint,4,opt,name=harga,proto3" json:"harga,omitempty"`
+	IdKategori   int64  `protobuf:"varint,5,opt,name=id_kategori,json=idKategori,proto3" json:"id_kategori,omitempty"`
+	NamaKategori string `protobuf:"bytes,6,opt,name=nama_kategori,json=namaKategori,proto3" json:"nama_kategori,omitempty"`
+	IdJenis      int64  `protobuf:"varint,7,opt,name=id_jenis,json=idJenis,proto3" json:"id_jenis,omitempty"`
+	NamaJenis    string `protobuf:"bytes,8,opt,name=nama_jenis,json=namaJenis,proto3" json:"nama_jenis,omitempty"`
+	IdMaterial   int64  `protobuf:"varint,9,opt,name=id_material,json=idMaterial,proto3" json:"id_material,omitempty"`
+	NamaMaterial string `protobuf:"bytes,10,opt,name=nama_material,json=namaMaterial,proto3" json:"nama_material,omitempty"`
 }
 
-func (x *ReadAllRequest) Reset() {
-	*x = ReadAllRequest{}
+func (x *Barang) Reset() {
+	*x = Barang{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -147,13 +204,13 @@ func (x *ReadAllRequest) Reset() {
 	}
 }
 
-func (x *ReadAllRequest) String() string {
+func (x *Barang) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadAllRequest) ProtoMessage() {}
+func (*Barang) ProtoMessage() {}
 
-func (x *ReadAllRequest) ProtoReflect() protoreflect.Message {
+func (x *Barang) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -165,21 +222,91 @@ func (x *ReadAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadAllRequest.ProtoReflect.Descriptor instead.
-func (*ReadAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Barang.ProtoReflect.Descriptor instead.
+func (*Barang) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{2}
 }
 
-type ReadAllResponse struct {
+func (x *Barang) GetIdBarang() int64 {
+	if x != nil {
+		return x.IdBarang
+	}
+	return 0
+}
+
+func (x *Barang) GetNamaBarang() string {
+	if x != nil {
+		return x.NamaBarang
+	}
+	return ""
+}
+
+func (x *Barang) GetFotoBarang() string {
+	if x != nil {
+		return x.FotoBarang
+	}
+	return ""
+}
+
+func (x *Barang) GetHarga() int32 {
+	if x != nil {
+		return x.Harga
+	}
+	return 0
+}
+
+func (x *Barang) GetIdKategori() int64 {
+	if x != nil {
+		return x.IdKategori
+	}
+	return 0
+}
+
+func (x *Barang) GetNamaKategori() string {
+	if x != nil {
+		return x.NamaKategori
+	}
+	return ""
+}
+
+func (x *Barang) GetIdJenis() int64 {
+	if x != nil {
+		return x.IdJenis
+	}
+	return 0
+}
+
+func (x *Barang) GetNamaJenis() string {
+	if x != nil {
+		return x.NamaJenis
+	}
+	return ""
+}
+
+func (x *Barang) GetIdMaterial() int64 {
+	if x != nil {
+		return x.IdMaterial
+	}
+	return 0
+}
+
+func (x *Barang) GetNamaMaterial() string {
+	if x != nil {
+		return x.NamaMaterial
+	}
+	return ""
+}
+
+type GetBarangRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Responses []*ResponseRead `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	IdBarang int64 `protobuf:"varint,1,opt,name=id_barang,json=idBarang,proto3" json:"id_barang,omitempty"`
 }
 
-func (x *ReadAllResponse) Reset() {
-	*x = ReadAllResponse{}
+func (x *GetBarangRequest) Reset() {
+	*x = GetBarangRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -187,13 +314,13 @@ func (x *ReadAllResponse) Reset() {
 	}
 }
 
-func (x *ReadAllResponse) String() string {
+func (x *GetBarangRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadAllResponse) ProtoMessage() {}
+func (*GetBarangRequest) ProtoMessage() {}
 
-func (x *ReadAllResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBarangRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -205,33 +332,28 @@ func (x *ReadAllResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadAllResponse.ProtoReflect.Descriptor instead.
-func (*ReadAllResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBarangRequest.ProtoReflect.Descriptor instead.
+func (*GetBarangRequest) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ReadAllResponse) GetResponses() []*ResponseRead {
+func (x *GetBarangRequest) GetIdBarang() int64 {
 	if x != nil {
-		return x.Responses
+		return x.IdBarang
 	}
-	return nil
+	return 0
 }
 
-type ResponseRead struct {
+type GetBarangResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NamaBarang   string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
-	FotoBarang   string `protobuf:"bytes,2,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
-	Harga        int32  `protobuf:"varint,3,opt,name=harga,proto3" json:"harga,omitempty"`
-	NamaKategori string `protobuf:"bytes,4,opt,name=nama_kategori,json=namaKategori,proto3" json:"nama_kategori,omitempty"`
-	NamaJenis    string `protobuf:"bytes,5,opt,name=nama_jenis,json=namaJenis,proto3" json:"nama_jenis,omitempty"`
-	NoBatch      string `protobuf:"bytes,6,opt,name=no_batch,json=noBatch,proto3" json:"no_batch,omitempty"`
+	Barang *Barang `protobuf:"bytes,1,opt,name=barang,proto3" json:"barang,omitempty"`
 }
 
-func (x *ResponseRead) Reset() {
-	*x = ResponseRead{}
+func (x *GetBarangResponse) Reset() {
+	*x = GetBarangResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -239,13 +361,13 @@ func (x *ResponseRead) Reset() {
 	}
 }
 
-func (x *ResponseRead) String() string {
+func (x *GetBarangResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseRead) ProtoMessage() {}
+func (*GetBarangResponse) ProtoMessage() {}
 
-func (x *ResponseRead) ProtoReflect() protoreflect.Message {
+func (x *GetBarangResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -257,76 +379,150 @@ func (x *ResponseRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseRead.ProtoReflect.Descriptor instead.
-func (*ResponseRead) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBarangResponse.ProtoReflect.Descriptor instead.
+func (*GetBarangResponse) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ResponseRead) GetNamaBarang() string {
+func (x *GetBarangResponse) GetBarang() *Barang {
+	if x != nil {
+		return x.Barang
+	}
+	return nil
+}
+
+// update_mask lists the columns to write, e.g. "harga" or "nama_barang".
+// When it is empty only the fields that are set in the request are updated.
+type UpdateBarangRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdBarang   int64                  `protobuf:"varint,1,opt,name=id_barang,json=idBarang,proto3" json:"id_barang,omitempty"`
+	NamaBarang string                 `protobuf:"bytes,2,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	FotoBarang string                 `protobuf:"bytes,3,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
+	Harga      int32                  `protobuf:"varint,4,opt,name=harga,proto3" json:"harga,omitempty"`
+	IdKategori int64                  `protobuf:"varint,5,opt,name=id_kategori,json=idKategori,proto3" json:"id_kategori,omitempty"`
+	IdJenis    int64                  `protobuf:"varint,6,opt,name=id_jenis,json=idJenis,proto3" json:"id_jenis,omitempty"`
+	IdMaterial int64                  `protobuf:"varint,7,opt,name=id_material,json=idMaterial,proto3" json:"id_material,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateBarangRequest) Reset() {
+	*x = UpdateBarangRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBarangRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBarangRequest) ProtoMessage() {}
+
+func (x *UpdateBarangRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBarangRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBarangRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateBarangRequest) GetIdBarang() int64 {
+	if x != nil {
+		return x.IdBarang
+	}
+	return 0
+}
+
+func (x *UpdateBarangRequest) GetNamaBarang() string {
 	if x != nil {
 		return x.NamaBarang
 	}
 	return ""
 }
 
-func (x *ResponseRead) GetFotoBarang() string {
+func (x *UpdateBarangRequest) GetFotoBarang() string {
 	if x != nil {
 		return x.FotoBarang
 	}
 	return ""
 }
 
-func (x *ResponseRead) GetHarga() int32 {
+func (x *UpdateBarangRequest) GetHarga() int32 {
 	if x != nil {
 		return x.Harga
 	}
 	return 0
 }
 
-func (x *ResponseRead) GetNamaKategori() string {
+func (x *UpdateBarangRequest) GetIdKategori() int64 {
 	if x != nil {
-		return x.NamaKategori
+		return x.IdKategori
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseRead) GetNamaJenis() string {
+func (x *UpdateBarangRequest) GetIdJenis() int64 {
 	if x != nil {
-		return x.NamaJenis
+		return x.IdJenis
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseRead) GetNoBatch() string {
+func (x *UpdateBarangRequest) GetIdMaterial() int64 {
 	if x != nil {
-		return x.NoBatch
+		return x.IdMaterial
 	}
-	return ""
+	return 0
 }
 
-type ReadWithCategoryRequest struct {
+func (x *UpdateBarangRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateBarangResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Success bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Barang  *Barang `protobuf:"bytes,3,opt,name=barang,proto3" json:"barang,omitempty"`
 }
 
-func (x *ReadWithCategoryRequest) Reset() {
-	*x = ReadWithCategoryRequest{}
+func (x *UpdateBarangResponse) Reset() {
+	*x = UpdateBarangResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[5]
+		mi := &file_proto_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadWithCategoryRequest) String() string {
+func (x *UpdateBarangResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadWithCategoryRequest) ProtoMessage() {}
+func (*UpdateBarangResponse) ProtoMessage() {}
 
-func (x *ReadWithCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[5]
+func (x *UpdateBarangResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -337,36 +533,57 @@ func (x *ReadWithCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadWithCategoryRequest.ProtoReflect.Descriptor instead.
-func (*ReadWithCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use UpdateBarangResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBarangResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{6}
 }
 
-type ReadWithCategoryResponse struct {
+func (x *UpdateBarangResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateBarangResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateBarangResponse) GetBarang() *Barang {
+	if x != nil {
+		return x.Barang
+	}
+	return nil
+}
+
+type DeleteBarangRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Responses []*ResponseReadCategory `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	IdBarang int64 `protobuf:"varint,1,opt,name=id_barang,json=idBarang,proto3" json:"id_barang,omitempty"`
 }
 
-func (x *ReadWithCategoryResponse) Reset() {
-	*x = ReadWithCategoryResponse{}
+func (x *DeleteBarangRequest) Reset() {
+	*x = DeleteBarangRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[6]
+		mi := &file_proto_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadWithCategoryResponse) String() string {
+func (x *DeleteBarangRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadWithCategoryResponse) ProtoMessage() {}
+func (*DeleteBarangRequest) ProtoMessage() {}
 
-func (x *ReadWithCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[6]
+func (x *DeleteBarangRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,46 +594,44 @@ func (x *ReadWithCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadWithCategoryResponse.ProtoReflect.Descriptor instead.
-func (*ReadWithCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use DeleteBarangRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBarangRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ReadWithCategoryResponse) GetResponses() []*ResponseReadCategory {
+func (x *DeleteBarangRequest) GetIdBarang() int64 {
 	if x != nil {
-		return x.Responses
+		return x.IdBarang
 	}
-	return nil
+	return 0
 }
 
-type ResponseReadCategory struct {
+type DeleteBarangResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NamaBarang   string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
-	FotoBarang   string `protobuf:"bytes,2,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
-	Harga        int32  `protobuf:"varint,3,opt,name=harga,proto3" json:"harga,omitempty"`
-	NamaKategori string `protobuf:"bytes,4,opt,name=nama_kategori,json=namaKategori,proto3" json:"nama_kategori,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ResponseReadCategory) Reset() {
-	*x = ResponseReadCategory{}
+func (x *DeleteBarangResponse) Reset() {
+	*x = DeleteBarangResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[7]
+		mi := &file_proto_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseReadCategory) String() string {
+func (x *DeleteBarangResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReadCategory) ProtoMessage() {}
+func (*DeleteBarangResponse) ProtoMessage() {}
 
-func (x *ResponseReadCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[7]
+func (x *DeleteBarangResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,62 +642,58 @@ func (x *ResponseReadCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReadCategory.ProtoReflect.Descriptor instead.
-func (*ResponseReadCategory) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *ResponseReadCategory) GetNamaBarang() string {
-	if x != nil {
-		return x.NamaBarang
-	}
-	return ""
-}
-
-func (x *ResponseReadCategory) GetFotoBarang() string {
-	if x != nil {
-		return x.FotoBarang
-	}
-	return ""
+// Deprecated: Use DeleteBarangResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBarangResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ResponseReadCategory) GetHarga() int32 {
+func (x *DeleteBarangResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Harga
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *ResponseReadCategory) GetNamaKategori() string {
+func (x *DeleteBarangResponse) GetMessage() string {
 	if x != nil {
-		return x.NamaKategori
+		return x.Message
 	}
 	return ""
 }
 
-type ReadWithJenisRequest struct {
+type ReadAllRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is only valid with the filter and order_by of the request it
+	// came from.
+	PageToken string         `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    *ReadAllFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order_by is one of "harga", "nama_barang" or "no_batch", optionally
+	// followed by "asc" or "desc", e.g. "harga desc". Defaults to insertion
+	// order. nama_barang and no_batch sort case-insensitively.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 }
 
-func (x *ReadWithJenisRequest) Reset() {
-	*x = ReadWithJenisRequest{}
+func (x *ReadAllRequest) Reset() {
+	*x = ReadAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[8]
+		mi := &file_proto_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadWithJenisRequest) String() string {
+func (x *ReadAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadWithJenisRequest) ProtoMessage() {}
+func (*ReadAllRequest) ProtoMessage() {}
 
-func (x *ReadWithJenisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[8]
+func (x *ReadAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,71 +704,58 @@ func (x *ReadWithJenisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadWithJenisRequest.ProtoReflect.Descriptor instead.
-func (*ReadWithJenisRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{8}
-}
-
-type ReadWithJenisResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Responses []*ResponseReadJenis `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+// Deprecated: Use ReadAllRequest.ProtoReflect.Descriptor instead.
+func (*ReadAllRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ReadWithJenisResponse) Reset() {
-	*x = ReadWithJenisResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReadAllRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
 	}
+	return 0
 }
 
-func (x *ReadWithJenisResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ReadWithJenisResponse) ProtoMessage() {}
-
-func (x *ReadWithJenisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReadAllRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ReadWithJenisResponse.ProtoReflect.Descriptor instead.
-func (*ReadWithJenisResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{9}
+func (x *ReadAllRequest) GetFilter() *ReadAllFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
 }
 
-func (x *ReadWithJenisResponse) GetResponses() []*ResponseReadJenis {
+func (x *ReadAllRequest) GetOrderBy() string {
 	if x != nil {
-		return x.Responses
+		return x.OrderBy
 	}
-	return nil
+	return ""
 }
 
-type ResponseReadJenis struct {
+// ReadAllFilter narrows ReadAll. Unset fields do not filter; all set fields
+// must match.
+type ReadAllFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NamaBarang string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
-	FotoBarang string `protobuf:"bytes,2,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
-	Harga      int32  `protobuf:"varint,3,opt,name=harga,proto3" json:"harga,omitempty"`
-	NamaJenis  string `protobuf:"bytes,4,opt,name=nama_jenis,json=namaJenis,proto3" json:"nama_jenis,omitempty"`
+	IdKategori int64  `protobuf:"varint,1,opt,name=id_kategori,json=idKategori,proto3" json:"id_kategori,omitempty"`
+	IdJenis    int64  `protobuf:"varint,2,opt,name=id_jenis,json=idJenis,proto3" json:"id_jenis,omitempty"`
+	IdMaterial int64  `protobuf:"varint,3,opt,name=id_material,json=idMaterial,proto3" json:"id_material,omitempty"`
+	HargaMin   *int32 `protobuf:"varint,4,opt,name=harga_min,json=hargaMin,proto3,oneof" json:"harga_min,omitempty"`
+	HargaMax   *int32 `protobuf:"varint,5,opt,name=harga_max,json=hargaMax,proto3,oneof" json:"harga_max,omitempty"`
+	// case-insensitive substring of nama_barang
+	NamaContains string `protobuf:"bytes,6,opt,name=nama_contains,json=namaContains,proto3" json:"nama_contains,omitempty"`
+	BatchPrefix  string `protobuf:"bytes,7,opt,name=batch_prefix,json=batchPrefix,proto3" json:"batch_prefix,omitempty"`
 }
 
-func (x *ResponseReadJenis) Reset() {
-	*x = ResponseReadJenis{}
+func (x *ReadAllFilter) Reset() {
+	*x = ReadAllFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -565,13 +763,13 @@ func (x *ResponseReadJenis) Reset() {
 	}
 }
 
-func (x *ResponseReadJenis) String() string {
+func (x *ReadAllFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReadJenis) ProtoMessage() {}
+func (*ReadAllFilter) ProtoMessage() {}
 
-func (x *ResponseReadJenis) ProtoReflect() protoreflect.Message {
+func (x *ReadAllFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -583,102 +781,87 @@ func (x *ResponseReadJenis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReadJenis.ProtoReflect.Descriptor instead.
-func (*ResponseReadJenis) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadAllFilter.ProtoReflect.Descriptor instead.
+func (*ReadAllFilter) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ResponseReadJenis) GetNamaBarang() string {
+func (x *ReadAllFilter) GetIdKategori() int64 {
 	if x != nil {
-		return x.NamaBarang
+		return x.IdKategori
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseReadJenis) GetFotoBarang() string {
+func (x *ReadAllFilter) GetIdJenis() int64 {
 	if x != nil {
-		return x.FotoBarang
+		return x.IdJenis
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseReadJenis) GetHarga() int32 {
+func (x *ReadAllFilter) GetIdMaterial() int64 {
 	if x != nil {
-		return x.Harga
+		return x.IdMaterial
 	}
 	return 0
 }
 
-func (x *ResponseReadJenis) GetNamaJenis() string {
-	if x != nil {
-		return x.NamaJenis
+func (x *ReadAllFilter) GetHargaMin() int32 {
+	if x != nil && x.HargaMin != nil {
+		return *x.HargaMin
 	}
-	return ""
-}
-
-type ReadWithMaterialRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *ReadWithMaterialRequest) Reset() {
-	*x = ReadWithMaterialRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReadAllFilter) GetHargaMax() int32 {
+	if x != nil && x.HargaMax != nil {
+		return *x.HargaMax
 	}
+	return 0
 }
 
-func (x *ReadWithMaterialRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ReadWithMaterialRequest) ProtoMessage() {}
-
-func (x *ReadWithMaterialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReadAllFilter) GetNamaContains() string {
+	if x != nil {
+		return x.NamaContains
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ReadWithMaterialRequest.ProtoReflect.Descriptor instead.
-func (*ReadWithMaterialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{11}
+func (x *ReadAllFilter) GetBatchPrefix() string {
+	if x != nil {
+		return x.BatchPrefix
+	}
+	return ""
 }
 
-type ReadWithMaterialResponse struct {
+type ReadAllResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Responses []*ResponseReadMaterial `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	Responses     []*ResponseRead `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32           `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 }
 
-func (x *ReadWithMaterialResponse) Reset() {
-	*x = ReadWithMaterialResponse{}
+func (x *ReadAllResponse) Reset() {
+	*x = ReadAllResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[12]
+		mi := &file_proto_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadWithMaterialResponse) String() string {
+func (x *ReadAllResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadWithMaterialResponse) ProtoMessage() {}
+func (*ReadAllResponse) ProtoMessage() {}
 
-func (x *ReadWithMaterialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[12]
+func (x *ReadAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,19 +872,33 @@ func (x *ReadWithMaterialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadWithMaterialResponse.ProtoReflect.Descriptor instead.
-func (*ReadWithMaterialResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ReadAllResponse.ProtoReflect.Descriptor instead.
+func (*ReadAllResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ReadWithMaterialResponse) GetResponses() []*ResponseReadMaterial {
+func (x *ReadAllResponse) GetResponses() []*ResponseRead {
 	if x != nil {
 		return x.Responses
 	}
 	return nil
 }
 
-type ResponseReadMaterial struct {
+func (x *ReadAllResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadAllResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ResponseRead struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -709,26 +906,28 @@ type ResponseReadMaterial struct {
 	NamaBarang   string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
 	FotoBarang   string `protobuf:"bytes,2,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
 	Harga        int32  `protobuf:"varint,3,opt,name=harga,proto3" json:"harga,omitempty"`
-	NamaMaterial string `protobuf:"bytes,4,opt,name=nama_material,json=namaMaterial,proto3" json:"nama_material,omitempty"`
+	NamaKategori string `protobuf:"bytes,4,opt,name=nama_kategori,json=namaKategori,proto3" json:"nama_kategori,omitempty"`
+	NamaJenis    string `protobuf:"bytes,5,opt,name=nama_jenis,json=namaJenis,proto3" json:"nama_jenis,omitempty"`
+	NoBatch      string `protobuf:"bytes,6,opt,name=no_batch,json=noBatch,proto3" json:"no_batch,omitempty"`
 }
 
-func (x *ResponseReadMaterial) Reset() {
-	*x = ResponseReadMaterial{}
+func (x *ResponseRead) Reset() {
+	*x = ResponseRead{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[13]
+		mi := &file_proto_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseReadMaterial) String() string {
+func (x *ResponseRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReadMaterial) ProtoMessage() {}
+func (*ResponseRead) ProtoMessage() {}
 
-func (x *ResponseReadMaterial) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[13]
+func (x *ResponseRead) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,62 +938,79 @@ func (x *ResponseReadMaterial) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReadMaterial.ProtoReflect.Descriptor instead.
-func (*ResponseReadMaterial) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ResponseRead.ProtoReflect.Descriptor instead.
+func (*ResponseRead) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ResponseReadMaterial) GetNamaBarang() string {
+func (x *ResponseRead) GetNamaBarang() string {
 	if x != nil {
 		return x.NamaBarang
 	}
 	return ""
 }
 
-func (x *ResponseReadMaterial) GetFotoBarang() string {
+func (x *ResponseRead) GetFotoBarang() string {
 	if x != nil {
 		return x.FotoBarang
 	}
 	return ""
 }
 
-func (x *ResponseReadMaterial) GetHarga() int32 {
+func (x *ResponseRead) GetHarga() int32 {
 	if x != nil {
 		return x.Harga
 	}
 	return 0
 }
 
-func (x *ResponseReadMaterial) GetNamaMaterial() string {
+func (x *ResponseRead) GetNamaKategori() string {
 	if x != nil {
-		return x.NamaMaterial
+		return x.NamaKategori
 	}
 	return ""
 }
 
-type ReadWithBatchRequest struct {
+func (x *ResponseRead) GetNamaJenis() string {
+	if x != nil {
+		return x.NamaJenis
+	}
+	return ""
+}
+
+func (x *ResponseRead) GetNoBatch() string {
+	if x != nil {
+		return x.NoBatch
+	}
+	return ""
+}
+
+type ReadWithCategoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *ReadWithBatchRequest) Reset() {
-	*x = ReadWithBatchRequest{}
+func (x *ReadWithCategoryRequest) Reset() {
+	*x = ReadWithCategoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[14]
+		mi := &file_proto_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadWithBatchRequest) String() string {
+func (x *ReadWithCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadWithBatchRequest) ProtoMessage() {}
+func (*ReadWithCategoryRequest) ProtoMessage() {}
 
-func (x *ReadWithBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[14]
+func (x *ReadWithCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,36 +1021,52 @@ func (x *ReadWithBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadWithBatchRequest.ProtoReflect.Descriptor instead.
-func (*ReadWithBatchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{14}
-}
+// Deprecated: Use ReadWithCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadWithCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{13}
+}
 
-type ReadWithBatchResponse struct {
+func (x *ReadWithCategoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadWithCategoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ReadWithCategoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Responses []*ResponseReadBatch `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	Responses     []*ResponseReadCategory `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 }
 
-func (x *ReadWithBatchResponse) Reset() {
-	*x = ReadWithBatchResponse{}
+func (x *ReadWithCategoryResponse) Reset() {
+	*x = ReadWithCategoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[15]
+		mi := &file_proto_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadWithBatchResponse) String() string {
+func (x *ReadWithCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadWithBatchResponse) ProtoMessage() {}
+func (*ReadWithCategoryResponse) ProtoMessage() {}
 
-func (x *ReadWithBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[15]
+func (x *ReadWithCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -845,46 +1077,60 @@ func (x *ReadWithBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadWithBatchResponse.ProtoReflect.Descriptor instead.
-func (*ReadWithBatchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ReadWithCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadWithCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ReadWithBatchResponse) GetResponses() []*ResponseReadBatch {
+func (x *ReadWithCategoryResponse) GetResponses() []*ResponseReadCategory {
 	if x != nil {
 		return x.Responses
 	}
 	return nil
 }
 
-type ResponseReadBatch struct {
+func (x *ReadWithCategoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadWithCategoryResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ResponseReadCategory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NamaBarang string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
-	FotoBarang string `protobuf:"bytes,2,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
-	Harga      int32  `protobuf:"varint,3,opt,name=harga,proto3" json:"harga,omitempty"`
-	NomorBatch string `protobuf:"bytes,4,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
+	NamaBarang   string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	FotoBarang   string `protobuf:"bytes,2,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
+	Harga        int32  `protobuf:"varint,3,opt,name=harga,proto3" json:"harga,omitempty"`
+	NamaKategori string `protobuf:"bytes,4,opt,name=nama_kategori,json=namaKategori,proto3" json:"nama_kategori,omitempty"`
 }
 
-func (x *ResponseReadBatch) Reset() {
-	*x = ResponseReadBatch{}
+func (x *ResponseReadCategory) Reset() {
+	*x = ResponseReadCategory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[16]
+		mi := &file_proto_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseReadBatch) String() string {
+func (x *ResponseReadCategory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReadBatch) ProtoMessage() {}
+func (*ResponseReadCategory) ProtoMessage() {}
 
-func (x *ResponseReadBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[16]
+func (x *ResponseReadCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,62 +1141,65 @@ func (x *ResponseReadBatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReadBatch.ProtoReflect.Descriptor instead.
-func (*ResponseReadBatch) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ResponseReadCategory.ProtoReflect.Descriptor instead.
+func (*ResponseReadCategory) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ResponseReadBatch) GetNamaBarang() string {
+func (x *ResponseReadCategory) GetNamaBarang() string {
 	if x != nil {
 		return x.NamaBarang
 	}
 	return ""
 }
 
-func (x *ResponseReadBatch) GetFotoBarang() string {
+func (x *ResponseReadCategory) GetFotoBarang() string {
 	if x != nil {
 		return x.FotoBarang
 	}
 	return ""
 }
 
-func (x *ResponseReadBatch) GetHarga() int32 {
+func (x *ResponseReadCategory) GetHarga() int32 {
 	if x != nil {
 		return x.Harga
 	}
 	return 0
 }
 
-func (x *ResponseReadBatch) GetNomorBatch() string {
+func (x *ResponseReadCategory) GetNamaKategori() string {
 	if x != nil {
-		return x.NomorBatch
+		return x.NamaKategori
 	}
 	return ""
 }
 
-type ReadNotExpiredBarangRequest struct {
+type ReadWithJenisRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *ReadNotExpiredBarangRequest) Reset() {
-	*x = ReadNotExpiredBarangRequest{}
+func (x *ReadWithJenisRequest) Reset() {
+	*x = ReadWithJenisRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[17]
+		mi := &file_proto_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadNotExpiredBarangRequest) String() string {
+func (x *ReadWithJenisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadNotExpiredBarangRequest) ProtoMessage() {}
+func (*ReadWithJenisRequest) ProtoMessage() {}
 
-func (x *ReadNotExpiredBarangRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[17]
+func (x *ReadWithJenisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,36 +1210,52 @@ func (x *ReadNotExpiredBarangRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadNotExpiredBarangRequest.ProtoReflect.Descriptor instead.
-func (*ReadNotExpiredBarangRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ReadWithJenisRequest.ProtoReflect.Descriptor instead.
+func (*ReadWithJenisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{16}
 }
 
-type ReadNotExpiredBarangResponse struct {
+func (x *ReadWithJenisRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadWithJenisRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ReadWithJenisResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Responses []*ResponseReadNotExpired `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	Responses     []*ResponseReadJenis `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	NextPageToken string               `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 }
 
-func (x *ReadNotExpiredBarangResponse) Reset() {
-	*x = ReadNotExpiredBarangResponse{}
+func (x *ReadWithJenisResponse) Reset() {
+	*x = ReadWithJenisResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[18]
+		mi := &file_proto_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadNotExpiredBarangResponse) String() string {
+func (x *ReadWithJenisResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadNotExpiredBarangResponse) ProtoMessage() {}
+func (*ReadWithJenisResponse) ProtoMessage() {}
 
-func (x *ReadNotExpiredBarangResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[18]
+func (x *ReadWithJenisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,46 +1266,60 @@ func (x *ReadNotExpiredBarangResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadNotExpiredBarangResponse.ProtoReflect.Descriptor instead.
-func (*ReadNotExpiredBarangResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ReadWithJenisResponse.ProtoReflect.Descriptor instead.
+func (*ReadWithJenisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ReadNotExpiredBarangResponse) GetResponses() []*ResponseReadNotExpired {
+func (x *ReadWithJenisResponse) GetResponses() []*ResponseReadJenis {
 	if x != nil {
 		return x.Responses
 	}
 	return nil
 }
 
-type ResponseReadNotExpired struct {
+func (x *ReadWithJenisResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadWithJenisResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ResponseReadJenis struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	NamaBarang string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
-	NomorBatch string `protobuf:"bytes,2,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
-	Stok       int32  `protobuf:"varint,3,opt,name=stok,proto3" json:"stok,omitempty"`
-	TglExpired string `protobuf:"bytes,4,opt,name=tgl_expired,json=tglExpired,proto3" json:"tgl_expired,omitempty"`
+	FotoBarang string `protobuf:"bytes,2,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
+	Harga      int32  `protobuf:"varint,3,opt,name=harga,proto3" json:"harga,omitempty"`
+	NamaJenis  string `protobuf:"bytes,4,opt,name=nama_jenis,json=namaJenis,proto3" json:"nama_jenis,omitempty"`
 }
 
-func (x *ResponseReadNotExpired) Reset() {
-	*x = ResponseReadNotExpired{}
+func (x *ResponseReadJenis) Reset() {
+	*x = ResponseReadJenis{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[19]
+		mi := &file_proto_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseReadNotExpired) String() string {
+func (x *ResponseReadJenis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReadNotExpired) ProtoMessage() {}
+func (*ResponseReadJenis) ProtoMessage() {}
 
-func (x *ResponseReadNotExpired) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[19]
+func (x *ResponseReadJenis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1051,62 +1330,65 @@ func (x *ResponseReadNotExpired) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReadNotExpired.ProtoReflect.Descriptor instead.
-func (*ResponseReadNotExpired) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ResponseReadJenis.ProtoReflect.Descriptor instead.
+func (*ResponseReadJenis) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ResponseReadNotExpired) GetNamaBarang() string {
+func (x *ResponseReadJenis) GetNamaBarang() string {
 	if x != nil {
 		return x.NamaBarang
 	}
 	return ""
 }
 
-func (x *ResponseReadNotExpired) GetNomorBatch() string {
+func (x *ResponseReadJenis) GetFotoBarang() string {
 	if x != nil {
-		return x.NomorBatch
+		return x.FotoBarang
 	}
 	return ""
 }
 
-func (x *ResponseReadNotExpired) GetStok() int32 {
+func (x *ResponseReadJenis) GetHarga() int32 {
 	if x != nil {
-		return x.Stok
+		return x.Harga
 	}
 	return 0
 }
 
-func (x *ResponseReadNotExpired) GetTglExpired() string {
+func (x *ResponseReadJenis) GetNamaJenis() string {
 	if x != nil {
-		return x.TglExpired
+		return x.NamaJenis
 	}
 	return ""
 }
 
-type ReadExpiredBarangRequest struct {
+type ReadWithMaterialRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *ReadExpiredBarangRequest) Reset() {
-	*x = ReadExpiredBarangRequest{}
+func (x *ReadWithMaterialRequest) Reset() {
+	*x = ReadWithMaterialRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[20]
+		mi := &file_proto_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadExpiredBarangRequest) String() string {
+func (x *ReadWithMaterialRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadExpiredBarangRequest) ProtoMessage() {}
+func (*ReadWithMaterialRequest) ProtoMessage() {}
 
-func (x *ReadExpiredBarangRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[20]
+func (x *ReadWithMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1117,36 +1399,52 @@ func (x *ReadExpiredBarangRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadExpiredBarangRequest.ProtoReflect.Descriptor instead.
-func (*ReadExpiredBarangRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ReadWithMaterialRequest.ProtoReflect.Descriptor instead.
+func (*ReadWithMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{19}
 }
 
-type ReadExpiredBarangResponse struct {
+func (x *ReadWithMaterialRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadWithMaterialRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ReadWithMaterialResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Responses []*ResponseReadExpired `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	Responses     []*ResponseReadMaterial `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 }
 
-func (x *ReadExpiredBarangResponse) Reset() {
-	*x = ReadExpiredBarangResponse{}
+func (x *ReadWithMaterialResponse) Reset() {
+	*x = ReadWithMaterialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[21]
+		mi := &file_proto_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadExpiredBarangResponse) String() string {
+func (x *ReadWithMaterialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadExpiredBarangResponse) ProtoMessage() {}
+func (*ReadWithMaterialResponse) ProtoMessage() {}
 
-func (x *ReadExpiredBarangResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[21]
+func (x *ReadWithMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,46 +1455,60 @@ func (x *ReadExpiredBarangResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadExpiredBarangResponse.ProtoReflect.Descriptor instead.
-func (*ReadExpiredBarangResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ReadWithMaterialResponse.ProtoReflect.Descriptor instead.
+func (*ReadWithMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ReadExpiredBarangResponse) GetResponses() []*ResponseReadExpired {
+func (x *ReadWithMaterialResponse) GetResponses() []*ResponseReadMaterial {
 	if x != nil {
 		return x.Responses
 	}
 	return nil
 }
 
-type ResponseReadExpired struct {
-	state         protoimpl.MessageState
+func (x *ReadWithMaterialResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadWithMaterialResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ResponseReadMaterial struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NamaBarang string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
-	NomorBatch string `protobuf:"bytes,2,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
-	Stok       int32  `protobuf:"varint,3,opt,name=stok,proto3" json:"stok,omitempty"`
-	TglExpired string `protobuf:"bytes,4,opt,name=tgl_expired,json=tglExpired,proto3" json:"tgl_expired,omitempty"`
+	NamaBarang   string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	FotoBarang   string `protobuf:"bytes,2,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
+	Harga        int32  `protobuf:"varint,3,opt,name=harga,proto3" json:"harga,omitempty"`
+	NamaMaterial string `protobuf:"bytes,4,opt,name=nama_material,json=namaMaterial,proto3" json:"nama_material,omitempty"`
 }
 
-func (x *ResponseReadExpired) Reset() {
-	*x = ResponseReadExpired{}
+func (x *ResponseReadMaterial) Reset() {
+	*x = ResponseReadMaterial{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[22]
+		mi := &file_proto_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseReadExpired) String() string {
+func (x *ResponseReadMaterial) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReadExpired) ProtoMessage() {}
+func (*ResponseReadMaterial) ProtoMessage() {}
 
-func (x *ResponseReadExpired) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[22]
+func (x *ResponseReadMaterial) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,50 +1519,106 @@ func (x *ResponseReadExpired) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReadExpired.ProtoReflect.Descriptor instead.
-func (*ResponseReadExpired) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ResponseReadMaterial.ProtoReflect.Descriptor instead.
+func (*ResponseReadMaterial) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ResponseReadExpired) GetNamaBarang() string {
+func (x *ResponseReadMaterial) GetNamaBarang() string {
 	if x != nil {
 		return x.NamaBarang
 	}
 	return ""
 }
 
-func (x *ResponseReadExpired) GetNomorBatch() string {
+func (x *ResponseReadMaterial) GetFotoBarang() string {
 	if x != nil {
-		return x.NomorBatch
+		return x.FotoBarang
 	}
 	return ""
 }
 
-func (x *ResponseReadExpired) GetStok() int32 {
+func (x *ResponseReadMaterial) GetHarga() int32 {
 	if x != nil {
-		return x.Stok
+		return x.Harga
 	}
 	return 0
 }
 
-func (x *ResponseReadExpired) GetTglExpired() string {
+func (x *ResponseReadMaterial) GetNamaMaterial() string {
 	if x != nil {
-		return x.TglExpired
+		return x.NamaMaterial
 	}
 	return ""
 }
 
-type UpdateHargaBatchRequest struct {
+type ReadWithBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Harga      int32  `protobuf:"varint,1,opt,name=harga,proto3" json:"harga,omitempty"`
-	NomorBatch string `protobuf:"bytes,2,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *UpdateHargaBatchRequest) Reset() {
-	*x = UpdateHargaBatchRequest{}
+func (x *ReadWithBatchRequest) Reset() {
+	*x = ReadWithBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadWithBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadWithBatchRequest) ProtoMessage() {}
+
+func (x *ReadWithBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadWithBatchRequest.ProtoReflect.Descriptor instead.
+func (*ReadWithBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReadWithBatchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadWithBatchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ReadWithBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses     []*ResponseReadBatch `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	NextPageToken string               `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+}
+
+func (x *ReadWithBatchResponse) Reset() {
+	*x = ReadWithBatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1258,13 +1626,13 @@ func (x *UpdateHargaBatchRequest) Reset() {
 	}
 }
 
-func (x *UpdateHargaBatchRequest) String() string {
+func (x *ReadWithBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateHargaBatchRequest) ProtoMessage() {}
+func (*ReadWithBatchResponse) ProtoMessage() {}
 
-func (x *UpdateHargaBatchRequest) ProtoReflect() protoreflect.Message {
+func (x *ReadWithBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1276,36 +1644,45 @@ func (x *UpdateHargaBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateHargaBatchRequest.ProtoReflect.Descriptor instead.
-func (*UpdateHargaBatchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadWithBatchResponse.ProtoReflect.Descriptor instead.
+func (*ReadWithBatchResponse) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateHargaBatchRequest) GetHarga() int32 {
+func (x *ReadWithBatchResponse) GetResponses() []*ResponseReadBatch {
 	if x != nil {
-		return x.Harga
+		return x.Responses
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateHargaBatchRequest) GetNomorBatch() string {
+func (x *ReadWithBatchResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.NomorBatch
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type UpdateHargaBatchResponse struct {
+func (x *ReadWithBatchResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ResponseReadBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	NamaBarang string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	FotoBarang string `protobuf:"bytes,2,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
+	Harga      int32  `protobuf:"varint,3,opt,name=harga,proto3" json:"harga,omitempty"`
+	NomorBatch string `protobuf:"bytes,4,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
 }
 
-func (x *UpdateHargaBatchResponse) Reset() {
-	*x = UpdateHargaBatchResponse{}
+func (x *ResponseReadBatch) Reset() {
+	*x = ResponseReadBatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1313,13 +1690,13 @@ func (x *UpdateHargaBatchResponse) Reset() {
 	}
 }
 
-func (x *UpdateHargaBatchResponse) String() string {
+func (x *ResponseReadBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateHargaBatchResponse) ProtoMessage() {}
+func (*ResponseReadBatch) ProtoMessage() {}
 
-func (x *UpdateHargaBatchResponse) ProtoReflect() protoreflect.Message {
+func (x *ResponseReadBatch) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1331,35 +1708,54 @@ func (x *UpdateHargaBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateHargaBatchResponse.ProtoReflect.Descriptor instead.
-func (*UpdateHargaBatchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseReadBatch.ProtoReflect.Descriptor instead.
+func (*ResponseReadBatch) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateHargaBatchResponse) GetSuccess() bool {
+func (x *ResponseReadBatch) GetNamaBarang() string {
 	if x != nil {
-		return x.Success
+		return x.NamaBarang
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateHargaBatchResponse) GetMessage() string {
+func (x *ResponseReadBatch) GetFotoBarang() string {
 	if x != nil {
-		return x.Message
+		return x.FotoBarang
 	}
 	return ""
 }
 
-type CreateBulkRefRequest struct {
+func (x *ResponseReadBatch) GetHarga() int32 {
+	if x != nil {
+		return x.Harga
+	}
+	return 0
+}
+
+func (x *ResponseReadBatch) GetNomorBatch() string {
+	if x != nil {
+		return x.NomorBatch
+	}
+	return ""
+}
+
+// A lot is still good on its tgl_expired and counts as expired from the next
+// day. "Today" is taken in the server's business timezone unless as_of
+// (YYYY-MM-DD) is given.
+type ReadNotExpiredBarangRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*CreateBulkRef `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	AsOf      string `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 }
 
-func (x *CreateBulkRefRequest) Reset() {
-	*x = CreateBulkRefRequest{}
+func (x *ReadNotExpiredBarangRequest) Reset() {
+	*x = ReadNotExpiredBarangRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1367,13 +1763,13 @@ func (x *CreateBulkRefRequest) Reset() {
 	}
 }
 
-func (x *CreateBulkRefRequest) String() string {
+func (x *ReadNotExpiredBarangRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBulkRefRequest) ProtoMessage() {}
+func (*ReadNotExpiredBarangRequest) ProtoMessage() {}
 
-func (x *CreateBulkRefRequest) ProtoReflect() protoreflect.Message {
+func (x *ReadNotExpiredBarangRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1385,29 +1781,44 @@ func (x *CreateBulkRefRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBulkRefRequest.ProtoReflect.Descriptor instead.
-func (*CreateBulkRefRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadNotExpiredBarangRequest.ProtoReflect.Descriptor instead.
+func (*ReadNotExpiredBarangRequest) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateBulkRefRequest) GetData() []*CreateBulkRef {
+func (x *ReadNotExpiredBarangRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Data
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type CreateBulkRefResponse struct {
+func (x *ReadNotExpiredBarangRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ReadNotExpiredBarangRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type ReadNotExpiredBarangResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Responses     []*ResponseReadNotExpired `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	NextPageToken string                    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                     `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 }
 
-func (x *CreateBulkRefResponse) Reset() {
-	*x = CreateBulkRefResponse{}
+func (x *ReadNotExpiredBarangResponse) Reset() {
+	*x = ReadNotExpiredBarangResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1415,407 +1826,4820 @@ func (x *CreateBulkRefResponse) Reset() {
 	}
 }
 
-func (x *CreateBulkRefResponse) String() string {
+func (x *ReadNotExpiredBarangResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBulkRefResponse) ProtoMessage() {}
+func (*ReadNotExpiredBarangResponse) ProtoMessage() {}
 
-func (x *CreateBulkRefResponse) ProtoReflect() protoreflect.Message {
+func (x *ReadNotExpiredBarangResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateBulkRefResponse.ProtoReflect.Descriptor instead.
-func (*CreateBulkRefResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *CreateBulkRefResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *CreateBulkRefResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type CreateBulkRef struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	IdBarang string `protobuf:"bytes,1,opt,name=id_barang,json=idBarang,proto3" json:"id_barang,omitempty"`
-	Stok     string `protobuf:"bytes,2,opt,name=stok,proto3" json:"stok,omitempty"`
-	ExpDate  string `protobuf:"bytes,3,opt,name=exp_date,json=expDate,proto3" json:"exp_date,omitempty"`
-	NoBatch  string `protobuf:"bytes,4,opt,name=no_batch,json=noBatch,proto3" json:"no_batch,omitempty"`
-}
-
-func (x *CreateBulkRef) Reset() {
-	*x = CreateBulkRef{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_service_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CreateBulkRef) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateBulkRef) ProtoMessage() {}
-
-func (x *CreateBulkRef) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadNotExpiredBarangResponse.ProtoReflect.Descriptor instead.
+func (*ReadNotExpiredBarangResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReadNotExpiredBarangResponse) GetResponses() []*ResponseReadNotExpired {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *ReadNotExpiredBarangResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadNotExpiredBarangResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ResponseReadNotExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamaBarang string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	NomorBatch string `protobuf:"bytes,2,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
+	Stok       int32  `protobuf:"varint,3,opt,name=stok,proto3" json:"stok,omitempty"`
+	TglExpired string `protobuf:"bytes,4,opt,name=tgl_expired,json=tglExpired,proto3" json:"tgl_expired,omitempty"`
+}
+
+func (x *ResponseReadNotExpired) Reset() {
+	*x = ResponseReadNotExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseReadNotExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseReadNotExpired) ProtoMessage() {}
+
+func (x *ResponseReadNotExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseReadNotExpired.ProtoReflect.Descriptor instead.
+func (*ResponseReadNotExpired) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ResponseReadNotExpired) GetNamaBarang() string {
+	if x != nil {
+		return x.NamaBarang
+	}
+	return ""
+}
+
+func (x *ResponseReadNotExpired) GetNomorBatch() string {
+	if x != nil {
+		return x.NomorBatch
+	}
+	return ""
+}
+
+func (x *ResponseReadNotExpired) GetStok() int32 {
+	if x != nil {
+		return x.Stok
+	}
+	return 0
+}
+
+func (x *ResponseReadNotExpired) GetTglExpired() string {
+	if x != nil {
+		return x.TglExpired
+	}
+	return ""
+}
+
+// Returns lots whose tgl_expired is before today, see ReadNotExpiredBarangRequest.
+type ReadExpiredBarangRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	AsOf      string `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *ReadExpiredBarangRequest) Reset() {
+	*x = ReadExpiredBarangRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadExpiredBarangRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadExpiredBarangRequest) ProtoMessage() {}
+
+func (x *ReadExpiredBarangRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadExpiredBarangRequest.ProtoReflect.Descriptor instead.
+func (*ReadExpiredBarangRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReadExpiredBarangRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadExpiredBarangRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ReadExpiredBarangRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type ReadExpiredBarangResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses     []*ResponseReadExpired `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+}
+
+func (x *ReadExpiredBarangResponse) Reset() {
+	*x = ReadExpiredBarangResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadExpiredBarangResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadExpiredBarangResponse) ProtoMessage() {}
+
+func (x *ReadExpiredBarangResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadExpiredBarangResponse.ProtoReflect.Descriptor instead.
+func (*ReadExpiredBarangResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReadExpiredBarangResponse) GetResponses() []*ResponseReadExpired {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *ReadExpiredBarangResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadExpiredBarangResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ResponseReadExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamaBarang string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	NomorBatch string `protobuf:"bytes,2,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
+	Stok       int32  `protobuf:"varint,3,opt,name=stok,proto3" json:"stok,omitempty"`
+	TglExpired string `protobuf:"bytes,4,opt,name=tgl_expired,json=tglExpired,proto3" json:"tgl_expired,omitempty"`
+}
+
+func (x *ResponseReadExpired) Reset() {
+	*x = ResponseReadExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseReadExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseReadExpired) ProtoMessage() {}
+
+func (x *ResponseReadExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseReadExpired.ProtoReflect.Descriptor instead.
+func (*ResponseReadExpired) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResponseReadExpired) GetNamaBarang() string {
+	if x != nil {
+		return x.NamaBarang
+	}
+	return ""
+}
+
+func (x *ResponseReadExpired) GetNomorBatch() string {
+	if x != nil {
+		return x.NomorBatch
+	}
+	return ""
+}
+
+func (x *ResponseReadExpired) GetStok() int32 {
+	if x != nil {
+		return x.Stok
+	}
+	return 0
+}
+
+func (x *ResponseReadExpired) GetTglExpired() string {
+	if x != nil {
+		return x.TglExpired
+	}
+	return ""
+}
+
+// ReadExpiringBarangRequest selects the lots expiring inside a window, either
+// the next within_days days (today included) or an explicit date range.
+type ReadExpiringBarangRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Window:
+	//	*ReadExpiringBarangRequest_WithinDays
+	//	*ReadExpiringBarangRequest_DateRange
+	Window     isReadExpiringBarangRequest_Window `protobuf_oneof:"window"`
+	IdKategori int64                              `protobuf:"varint,3,opt,name=id_kategori,json=idKategori,proto3" json:"id_kategori,omitempty"`
+	PageSize   int32                              `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken  string                             `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// today's date for within_days and days_remaining, YYYY-MM-DD
+	AsOf string `protobuf:"bytes,6,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *ReadExpiringBarangRequest) Reset() {
+	*x = ReadExpiringBarangRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadExpiringBarangRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadExpiringBarangRequest) ProtoMessage() {}
+
+func (x *ReadExpiringBarangRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadExpiringBarangRequest.ProtoReflect.Descriptor instead.
+func (*ReadExpiringBarangRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (m *ReadExpiringBarangRequest) GetWindow() isReadExpiringBarangRequest_Window {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (x *ReadExpiringBarangRequest) GetWithinDays() int32 {
+	if x, ok := x.GetWindow().(*ReadExpiringBarangRequest_WithinDays); ok {
+		return x.WithinDays
+	}
+	return 0
+}
+
+func (x *ReadExpiringBarangRequest) GetDateRange() *DateRange {
+	if x, ok := x.GetWindow().(*ReadExpiringBarangRequest_DateRange); ok {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *ReadExpiringBarangRequest) GetIdKategori() int64 {
+	if x != nil {
+		return x.IdKategori
+	}
+	return 0
+}
+
+func (x *ReadExpiringBarangRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadExpiringBarangRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ReadExpiringBarangRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type isReadExpiringBarangRequest_Window interface {
+	isReadExpiringBarangRequest_Window()
+}
+
+type ReadExpiringBarangRequest_WithinDays struct {
+	// within_days is at most ten years.
+	WithinDays int32 `protobuf:"varint,1,opt,name=within_days,json=withinDays,proto3,oneof"`
+}
+
+type ReadExpiringBarangRequest_DateRange struct {
+	DateRange *DateRange `protobuf:"bytes,2,opt,name=date_range,json=dateRange,proto3,oneof"`
+}
+
+func (*ReadExpiringBarangRequest_WithinDays) isReadExpiringBarangRequest_Window() {}
+
+func (*ReadExpiringBarangRequest_DateRange) isReadExpiringBarangRequest_Window() {}
+
+// DateRange is inclusive on both ends, dates are YYYY-MM-DD.
+type DateRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DateRange) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DateRange) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type ReadExpiringBarangResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses     []*ResponseReadExpiring `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+}
+
+func (x *ReadExpiringBarangResponse) Reset() {
+	*x = ReadExpiringBarangResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadExpiringBarangResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadExpiringBarangResponse) ProtoMessage() {}
+
+func (x *ReadExpiringBarangResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadExpiringBarangResponse.ProtoReflect.Descriptor instead.
+func (*ReadExpiringBarangResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReadExpiringBarangResponse) GetResponses() []*ResponseReadExpiring {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *ReadExpiringBarangResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ReadExpiringBarangResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ResponseReadExpiring struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamaBarang   string `protobuf:"bytes,1,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	NomorBatch   string `protobuf:"bytes,2,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
+	Stok         int32  `protobuf:"varint,3,opt,name=stok,proto3" json:"stok,omitempty"`
+	TglExpired   string `protobuf:"bytes,4,opt,name=tgl_expired,json=tglExpired,proto3" json:"tgl_expired,omitempty"`
+	NamaKategori string `protobuf:"bytes,5,opt,name=nama_kategori,json=namaKategori,proto3" json:"nama_kategori,omitempty"`
+	// days from today until tgl_expired, 0 when it expires today
+	DaysRemaining int32 `protobuf:"varint,6,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`
+}
+
+func (x *ResponseReadExpiring) Reset() {
+	*x = ResponseReadExpiring{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseReadExpiring) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseReadExpiring) ProtoMessage() {}
+
+func (x *ResponseReadExpiring) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseReadExpiring.ProtoReflect.Descriptor instead.
+func (*ResponseReadExpiring) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResponseReadExpiring) GetNamaBarang() string {
+	if x != nil {
+		return x.NamaBarang
+	}
+	return ""
+}
+
+func (x *ResponseReadExpiring) GetNomorBatch() string {
+	if x != nil {
+		return x.NomorBatch
+	}
+	return ""
+}
+
+func (x *ResponseReadExpiring) GetStok() int32 {
+	if x != nil {
+		return x.Stok
+	}
+	return 0
+}
+
+func (x *ResponseReadExpiring) GetTglExpired() string {
+	if x != nil {
+		return x.TglExpired
+	}
+	return ""
+}
+
+func (x *ResponseReadExpiring) GetNamaKategori() string {
+	if x != nil {
+		return x.NamaKategori
+	}
+	return ""
+}
+
+func (x *ResponseReadExpiring) GetDaysRemaining() int32 {
+	if x != nil {
+		return x.DaysRemaining
+	}
+	return 0
+}
+
+type StreamAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamAllRequest) Reset() {
+	*x = StreamAllRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAllRequest) ProtoMessage() {}
+
+func (x *StreamAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAllRequest.ProtoReflect.Descriptor instead.
+func (*StreamAllRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{35}
+}
+
+type StreamExpiredBarangRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AsOf string `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *StreamExpiredBarangRequest) Reset() {
+	*x = StreamExpiredBarangRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamExpiredBarangRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExpiredBarangRequest) ProtoMessage() {}
+
+func (x *StreamExpiredBarangRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExpiredBarangRequest.ProtoReflect.Descriptor instead.
+func (*StreamExpiredBarangRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StreamExpiredBarangRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type StreamNotExpiredBarangRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AsOf string `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *StreamNotExpiredBarangRequest) Reset() {
+	*x = StreamNotExpiredBarangRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamNotExpiredBarangRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNotExpiredBarangRequest) ProtoMessage() {}
+
+func (x *StreamNotExpiredBarangRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNotExpiredBarangRequest.ProtoReflect.Descriptor instead.
+func (*StreamNotExpiredBarangRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StreamNotExpiredBarangRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type UpdateHargaBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Harga      int32  `protobuf:"varint,1,opt,name=harga,proto3" json:"harga,omitempty"`
+	NomorBatch string `protobuf:"bytes,2,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
+}
+
+func (x *UpdateHargaBatchRequest) Reset() {
+	*x = UpdateHargaBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHargaBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHargaBatchRequest) ProtoMessage() {}
+
+func (x *UpdateHargaBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHargaBatchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHargaBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateHargaBatchRequest) GetHarga() int32 {
+	if x != nil {
+		return x.Harga
+	}
+	return 0
+}
+
+func (x *UpdateHargaBatchRequest) GetNomorBatch() string {
+	if x != nil {
+		return x.NomorBatch
+	}
+	return ""
+}
+
+type UpdateHargaBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UpdateHargaBatchResponse) Reset() {
+	*x = UpdateHargaBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHargaBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHargaBatchResponse) ProtoMessage() {}
+
+func (x *UpdateHargaBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHargaBatchResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHargaBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateHargaBatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateHargaBatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// By default the request is all-or-nothing. With best_effort rows that fail
+// are skipped and the remaining rows are still committed. The rows carry no
+// validation constraints on purpose: a bad row must be reported in errors,
+// not fail the whole request.
+// New clients should send data_v2; data is kept for existing clients. Row
+// indices in errors count data first, then data_v2.
+type CreateBulkRefRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data       []*CreateBulkRef   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	BestEffort bool               `protobuf:"varint,2,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	DataV2     []*CreateBulkRefV2 `protobuf:"bytes,3,rep,name=data_v2,json=dataV2,proto3" json:"data_v2,omitempty"`
+}
+
+func (x *CreateBulkRefRequest) Reset() {
+	*x = CreateBulkRefRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBulkRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBulkRefRequest) ProtoMessage() {}
+
+func (x *CreateBulkRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBulkRefRequest.ProtoReflect.Descriptor instead.
+func (*CreateBulkRefRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateBulkRefRequest) GetData() []*CreateBulkRef {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateBulkRefRequest) GetBestEffort() bool {
+	if x != nil {
+		return x.BestEffort
+	}
+	return false
+}
+
+func (x *CreateBulkRefRequest) GetDataV2() []*CreateBulkRefV2 {
+	if x != nil {
+		return x.DataV2
+	}
+	return nil
+}
+
+type CreateBulkRefResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Inserted int64       `protobuf:"varint,3,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Errors   []*RowError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *CreateBulkRefResponse) Reset() {
+	*x = CreateBulkRefResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBulkRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBulkRefResponse) ProtoMessage() {}
+
+func (x *CreateBulkRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBulkRefResponse.ProtoReflect.Descriptor instead.
+func (*CreateBulkRefResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateBulkRefResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateBulkRefResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateBulkRefResponse) GetInserted() int64 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *CreateBulkRefResponse) GetErrors() []*RowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreateBulkRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdBarang string `protobuf:"bytes,1,opt,name=id_barang,json=idBarang,proto3" json:"id_barang,omitempty"`
+	Stok     string `protobuf:"bytes,2,opt,name=stok,proto3" json:"stok,omitempty"`
+	ExpDate  string `protobuf:"bytes,3,opt,name=exp_date,json=expDate,proto3" json:"exp_date,omitempty"`
+	NoBatch  string `protobuf:"bytes,4,opt,name=no_batch,json=noBatch,proto3" json:"no_batch,omitempty"`
+}
+
+func (x *CreateBulkRef) Reset() {
+	*x = CreateBulkRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBulkRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBulkRef) ProtoMessage() {}
+
+func (x *CreateBulkRef) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBulkRef.ProtoReflect.Descriptor instead.
+func (*CreateBulkRef) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateBulkRef) GetIdBarang() string {
+	if x != nil {
+		return x.IdBarang
+	}
+	return ""
+}
+
+func (x *CreateBulkRef) GetStok() string {
+	if x != nil {
+		return x.Stok
+	}
+	return ""
+}
+
+func (x *CreateBulkRef) GetExpDate() string {
+	if x != nil {
+		return x.ExpDate
+	}
+	return ""
+}
+
+func (x *CreateBulkRef) GetNoBatch() string {
+	if x != nil {
+		return x.NoBatch
+	}
+	return ""
+}
+
+// CreateBulkRefV2 is CreateBulkRef with typed fields. Only the calendar date
+// of exp_date in the server's business timezone is stored.
+type CreateBulkRefV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdBarang int64                  `protobuf:"varint,1,opt,name=id_barang,json=idBarang,proto3" json:"id_barang,omitempty"`
+	Stok     int32                  `protobuf:"varint,2,opt,name=stok,proto3" json:"stok,omitempty"`
+	ExpDate  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=exp_date,json=expDate,proto3" json:"exp_date,omitempty"`
+	NoBatch  string                 `protobuf:"bytes,4,opt,name=no_batch,json=noBatch,proto3" json:"no_batch,omitempty"`
+}
+
+func (x *CreateBulkRefV2) Reset() {
+	*x = CreateBulkRefV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBulkRefV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBulkRefV2) ProtoMessage() {}
+
+func (x *CreateBulkRefV2) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBulkRefV2.ProtoReflect.Descriptor instead.
+func (*CreateBulkRefV2) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateBulkRefV2) GetIdBarang() int64 {
+	if x != nil {
+		return x.IdBarang
+	}
+	return 0
+}
+
+func (x *CreateBulkRefV2) GetStok() int32 {
+	if x != nil {
+		return x.Stok
+	}
+	return 0
+}
+
+func (x *CreateBulkRefV2) GetExpDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpDate
+	}
+	return nil
+}
+
+func (x *CreateBulkRefV2) GetNoBatch() string {
+	if x != nil {
+		return x.NoBatch
+	}
+	return ""
+}
+
+// RowError reports why the row at index (0-based, in the order the client
+// sent it) was not inserted.
+type RowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index   int64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	NoBatch string `protobuf:"bytes,2,opt,name=no_batch,json=noBatch,proto3" json:"no_batch,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RowError) Reset() {
+	*x = RowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowError) ProtoMessage() {}
+
+func (x *RowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowError.ProtoReflect.Descriptor instead.
+func (*RowError) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RowError) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RowError) GetNoBatch() string {
+	if x != nil {
+		return x.NoBatch
+	}
+	return ""
+}
+
+func (x *RowError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ImportRefResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inserted int64 `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	// failed counts every row that was not inserted.
+	Failed int64 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// errors lists the failed rows with the lowest indexes, at most 1000 of
+	// them, so it can be shorter than failed.
+	Errors []*RowError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportRefResponse) Reset() {
+	*x = ImportRefResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRefResponse) ProtoMessage() {}
+
+func (x *ImportRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRefResponse.ProtoReflect.Descriptor instead.
+func (*ImportRefResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportRefResponse) GetInserted() int64 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *ImportRefResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportRefResponse) GetErrors() []*RowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Batch is ResponseReadBatch plus the stock and expiry of the lot.
+type Batch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdRefBarang int64  `protobuf:"varint,1,opt,name=id_ref_barang,json=idRefBarang,proto3" json:"id_ref_barang,omitempty"`
+	IdBarang    int64  `protobuf:"varint,2,opt,name=id_barang,json=idBarang,proto3" json:"id_barang,omitempty"`
+	NamaBarang  string `protobuf:"bytes,3,opt,name=nama_barang,json=namaBarang,proto3" json:"nama_barang,omitempty"`
+	FotoBarang  string `protobuf:"bytes,4,opt,name=foto_barang,json=fotoBarang,proto3" json:"foto_barang,omitempty"`
+	Harga       int32  `protobuf:"varint,5,opt,name=harga,proto3" json:"harga,omitempty"`
+	NomorBatch  string `protobuf:"bytes,6,opt,name=nomor_batch,json=nomorBatch,proto3" json:"nomor_batch,omitempty"`
+	Stok        int32  `protobuf:"varint,7,opt,name=stok,proto3" json:"stok,omitempty"`
+	TglExpired  string `protobuf:"bytes,8,opt,name=tgl_expired,json=tglExpired,proto3" json:"tgl_expired,omitempty"`
+}
+
+func (x *Batch) Reset() {
+	*x = Batch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch) ProtoMessage() {}
+
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Batch) GetIdRefBarang() int64 {
+	if x != nil {
+		return x.IdRefBarang
+	}
+	return 0
+}
+
+func (x *Batch) GetIdBarang() int64 {
+	if x != nil {
+		return x.IdBarang
+	}
+	return 0
+}
+
+func (x *Batch) GetNamaBarang() string {
+	if x != nil {
+		return x.NamaBarang
+	}
+	return ""
+}
+
+func (x *Batch) GetFotoBarang() string {
+	if x != nil {
+		return x.FotoBarang
+	}
+	return ""
+}
+
+func (x *Batch) GetHarga() int32 {
+	if x != nil {
+		return x.Harga
+	}
+	return 0
+}
+
+func (x *Batch) GetNomorBatch() string {
+	if x != nil {
+		return x.NomorBatch
+	}
+	return ""
+}
+
+func (x *Batch) GetStok() int32 {
+	if x != nil {
+		return x.Stok
+	}
+	return 0
+}
+
+func (x *Batch) GetTglExpired() string {
+	if x != nil {
+		return x.TglExpired
+	}
+	return ""
+}
+
+type GetBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//	*GetBatchRequest_IdRefBarang
+	//	*GetBatchRequest_NoBatch
+	Key isGetBatchRequest_Key `protobuf_oneof:"key"`
+}
+
+func (x *GetBatchRequest) Reset() {
+	*x = GetBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchRequest) ProtoMessage() {}
+
+func (x *GetBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (m *GetBatchRequest) GetKey() isGetBatchRequest_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (x *GetBatchRequest) GetIdRefBarang() int64 {
+	if x, ok := x.GetKey().(*GetBatchRequest_IdRefBarang); ok {
+		return x.IdRefBarang
+	}
+	return 0
+}
+
+func (x *GetBatchRequest) GetNoBatch() string {
+	if x, ok := x.GetKey().(*GetBatchRequest_NoBatch); ok {
+		return x.NoBatch
+	}
+	return ""
+}
+
+type isGetBatchRequest_Key interface {
+	isGetBatchRequest_Key()
+}
+
+type GetBatchRequest_IdRefBarang struct {
+	IdRefBarang int64 `protobuf:"varint,1,opt,name=id_ref_barang,json=idRefBarang,proto3,oneof"`
+}
+
+type GetBatchRequest_NoBatch struct {
+	NoBatch string `protobuf:"bytes,2,opt,name=no_batch,json=noBatch,proto3,oneof"`
+}
+
+func (*GetBatchRequest_IdRefBarang) isGetBatchRequest_Key() {}
+
+func (*GetBatchRequest_NoBatch) isGetBatchRequest_Key() {}
+
+type GetBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Batch *Batch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *GetBatchResponse) Reset() {
+	*x = GetBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchResponse) ProtoMessage() {}
+
+func (x *GetBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetBatchResponse) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+// update_mask accepts "stok" and "tgl_expired" (YYYY-MM-DD). When it is
+// empty only the fields that are set in the request are updated.
+type UpdateBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//	*UpdateBatchRequest_IdRefBarang
+	//	*UpdateBatchRequest_NoBatch
+	Key        isUpdateBatchRequest_Key `protobuf_oneof:"key"`
+	Stok       int32                    `protobuf:"varint,3,opt,name=stok,proto3" json:"stok,omitempty"`
+	TglExpired string                   `protobuf:"bytes,4,opt,name=tgl_expired,json=tglExpired,proto3" json:"tgl_expired,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask   `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateBatchRequest) Reset() {
+	*x = UpdateBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchRequest) ProtoMessage() {}
+
+func (x *UpdateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (m *UpdateBatchRequest) GetKey() isUpdateBatchRequest_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (x *UpdateBatchRequest) GetIdRefBarang() int64 {
+	if x, ok := x.GetKey().(*UpdateBatchRequest_IdRefBarang); ok {
+		return x.IdRefBarang
+	}
+	return 0
+}
+
+func (x *UpdateBatchRequest) GetNoBatch() string {
+	if x, ok := x.GetKey().(*UpdateBatchRequest_NoBatch); ok {
+		return x.NoBatch
+	}
+	return ""
+}
+
+func (x *UpdateBatchRequest) GetStok() int32 {
+	if x != nil {
+		return x.Stok
+	}
+	return 0
+}
+
+func (x *UpdateBatchRequest) GetTglExpired() string {
+	if x != nil {
+		return x.TglExpired
+	}
+	return ""
+}
+
+func (x *UpdateBatchRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type isUpdateBatchRequest_Key interface {
+	isUpdateBatchRequest_Key()
+}
+
+type UpdateBatchRequest_IdRefBarang struct {
+	IdRefBarang int64 `protobuf:"varint,1,opt,name=id_ref_barang,json=idRefBarang,proto3,oneof"`
+}
+
+type UpdateBatchRequest_NoBatch struct {
+	NoBatch string `protobuf:"bytes,2,opt,name=no_batch,json=noBatch,proto3,oneof"`
+}
+
+func (*UpdateBatchRequest_IdRefBarang) isUpdateBatchRequest_Key() {}
+
+func (*UpdateBatchRequest_NoBatch) isUpdateBatchRequest_Key() {}
+
+type UpdateBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Batch   *Batch `protobuf:"bytes,3,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *UpdateBatchResponse) Reset() {
+	*x = UpdateBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBatchResponse) ProtoMessage() {}
+
+func (x *UpdateBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBatchResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateBatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateBatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateBatchResponse) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type DeleteBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//	*DeleteBatchRequest_IdRefBarang
+	//	*DeleteBatchRequest_NoBatch
+	Key isDeleteBatchRequest_Key `protobuf_oneof:"key"`
+}
+
+func (x *DeleteBatchRequest) Reset() {
+	*x = DeleteBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBatchRequest) ProtoMessage() {}
+
+func (x *DeleteBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBatchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (m *DeleteBatchRequest) GetKey() isDeleteBatchRequest_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (x *DeleteBatchRequest) GetIdRefBarang() int64 {
+	if x, ok := x.GetKey().(*DeleteBatchRequest_IdRefBarang); ok {
+		return x.IdRefBarang
+	}
+	return 0
+}
+
+func (x *DeleteBatchRequest) GetNoBatch() string {
+	if x, ok := x.GetKey().(*DeleteBatchRequest_NoBatch); ok {
+		return x.NoBatch
+	}
+	return ""
+}
+
+type isDeleteBatchRequest_Key interface {
+	isDeleteBatchRequest_Key()
+}
+
+type DeleteBatchRequest_IdRefBarang struct {
+	IdRefBarang int64 `protobuf:"varint,1,opt,name=id_ref_barang,json=idRefBarang,proto3,oneof"`
+}
+
+type DeleteBatchRequest_NoBatch struct {
+	NoBatch string `protobuf:"bytes,2,opt,name=no_batch,json=noBatch,proto3,oneof"`
+}
+
+func (*DeleteBatchRequest_IdRefBarang) isDeleteBatchRequest_Key() {}
+
+func (*DeleteBatchRequest_NoBatch) isDeleteBatchRequest_Key() {}
+
+type DeleteBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteBatchResponse) Reset() {
+	*x = DeleteBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBatchResponse) ProtoMessage() {}
+
+func (x *DeleteBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBatchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteBatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteBatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Kategori struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdKategori   int64  `protobuf:"varint,1,opt,name=id_kategori,json=idKategori,proto3" json:"id_kategori,omitempty"`
+	NamaKategori string `protobuf:"bytes,2,opt,name=nama_kategori,json=namaKategori,proto3" json:"nama_kategori,omitempty"`
+}
+
+func (x *Kategori) Reset() {
+	*x = Kategori{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Kategori) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kategori) ProtoMessage() {}
+
+func (x *Kategori) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kategori.ProtoReflect.Descriptor instead.
+func (*Kategori) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Kategori) GetIdKategori() int64 {
+	if x != nil {
+		return x.IdKategori
+	}
+	return 0
+}
+
+func (x *Kategori) GetNamaKategori() string {
+	if x != nil {
+		return x.NamaKategori
+	}
+	return ""
+}
+
+type ListKategoriRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListKategoriRequest) Reset() {
+	*x = ListKategoriRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKategoriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKategoriRequest) ProtoMessage() {}
+
+func (x *ListKategoriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKategoriRequest.ProtoReflect.Descriptor instead.
+func (*ListKategoriRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{54}
+}
+
+type ListKategoriResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses []*Kategori `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *ListKategoriResponse) Reset() {
+	*x = ListKategoriResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKategoriResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKategoriResponse) ProtoMessage() {}
+
+func (x *ListKategoriResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKategoriResponse.ProtoReflect.Descriptor instead.
+func (*ListKategoriResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListKategoriResponse) GetResponses() []*Kategori {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type CreateKategoriRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamaKategori string `protobuf:"bytes,1,opt,name=nama_kategori,json=namaKategori,proto3" json:"nama_kategori,omitempty"`
+}
+
+func (x *CreateKategoriRequest) Reset() {
+	*x = CreateKategoriRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateKategoriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKategoriRequest) ProtoMessage() {}
+
+func (x *CreateKategoriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKategoriRequest.ProtoReflect.Descriptor instead.
+func (*CreateKategoriRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateKategoriRequest) GetNamaKategori() string {
+	if x != nil {
+		return x.NamaKategori
+	}
+	return ""
+}
+
+type CreateKategoriResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Kategori *Kategori `protobuf:"bytes,3,opt,name=kategori,proto3" json:"kategori,omitempty"`
+}
+
+func (x *CreateKategoriResponse) Reset() {
+	*x = CreateKategoriResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateKategoriResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKategoriResponse) ProtoMessage() {}
+
+func (x *CreateKategoriResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKategoriResponse.ProtoReflect.Descriptor instead.
+func (*CreateKategoriResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateKategoriResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateKategoriResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateKategoriResponse) GetKategori() *Kategori {
+	if x != nil {
+		return x.Kategori
+	}
+	return nil
+}
+
+type UpdateKategoriRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdKategori   int64  `protobuf:"varint,1,opt,name=id_kategori,json=idKategori,proto3" json:"id_kategori,omitempty"`
+	NamaKategori string `protobuf:"bytes,2,opt,name=nama_kategori,json=namaKategori,proto3" json:"nama_kategori,omitempty"`
+}
+
+func (x *UpdateKategoriRequest) Reset() {
+	*x = UpdateKategoriRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateKategoriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKategoriRequest) ProtoMessage() {}
+
+func (x *UpdateKategoriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKategoriRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKategoriRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateKategoriRequest) GetIdKategori() int64 {
+	if x != nil {
+		return x.IdKategori
+	}
+	return 0
+}
+
+func (x *UpdateKategoriRequest) GetNamaKategori() string {
+	if x != nil {
+		return x.NamaKategori
+	}
+	return ""
+}
+
+type UpdateKategoriResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Kategori *Kategori `protobuf:"bytes,3,opt,name=kategori,proto3" json:"kategori,omitempty"`
+}
+
+func (x *UpdateKategoriResponse) Reset() {
+	*x = UpdateKategoriResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateKategoriResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKategoriResponse) ProtoMessage() {}
+
+func (x *UpdateKategoriResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKategoriResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKategoriResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateKategoriResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateKategoriResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateKategoriResponse) GetKategori() *Kategori {
+	if x != nil {
+		return x.Kategori
+	}
+	return nil
+}
+
+type DeleteKategoriRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdKategori int64 `protobuf:"varint,1,opt,name=id_kategori,json=idKategori,proto3" json:"id_kategori,omitempty"`
+}
+
+func (x *DeleteKategoriRequest) Reset() {
+	*x = DeleteKategoriRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteKategoriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKategoriRequest) ProtoMessage() {}
+
+func (x *DeleteKategoriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKategoriRequest.ProtoReflect.Descriptor instead.
+func (*DeleteKategoriRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteKategoriRequest) GetIdKategori() int64 {
+	if x != nil {
+		return x.IdKategori
+	}
+	return 0
+}
+
+type DeleteKategoriResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteKategoriResponse) Reset() {
+	*x = DeleteKategoriResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteKategoriResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKategoriResponse) ProtoMessage() {}
+
+func (x *DeleteKategoriResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKategoriResponse.ProtoReflect.Descriptor instead.
+func (*DeleteKategoriResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteKategoriResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteKategoriResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Jenis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdJenis   int64  `protobuf:"varint,1,opt,name=id_jenis,json=idJenis,proto3" json:"id_jenis,omitempty"`
+	NamaJenis string `protobuf:"bytes,2,opt,name=nama_jenis,json=namaJenis,proto3" json:"nama_jenis,omitempty"`
+}
+
+func (x *Jenis) Reset() {
+	*x = Jenis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Jenis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jenis) ProtoMessage() {}
+
+func (x *Jenis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jenis.ProtoReflect.Descriptor instead.
+func (*Jenis) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Jenis) GetIdJenis() int64 {
+	if x != nil {
+		return x.IdJenis
+	}
+	return 0
+}
+
+func (x *Jenis) GetNamaJenis() string {
+	if x != nil {
+		return x.NamaJenis
+	}
+	return ""
+}
+
+type ListJenisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListJenisRequest) Reset() {
+	*x = ListJenisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJenisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJenisRequest) ProtoMessage() {}
+
+func (x *ListJenisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJenisRequest.ProtoReflect.Descriptor instead.
+func (*ListJenisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{63}
+}
+
+type ListJenisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses []*Jenis `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *ListJenisResponse) Reset() {
+	*x = ListJenisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJenisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJenisResponse) ProtoMessage() {}
+
+func (x *ListJenisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJenisResponse.ProtoReflect.Descriptor instead.
+func (*ListJenisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListJenisResponse) GetResponses() []*Jenis {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type CreateJenisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamaJenis string `protobuf:"bytes,1,opt,name=nama_jenis,json=namaJenis,proto3" json:"nama_jenis,omitempty"`
+}
+
+func (x *CreateJenisRequest) Reset() {
+	*x = CreateJenisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateJenisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJenisRequest) ProtoMessage() {}
+
+func (x *CreateJenisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJenisRequest.ProtoReflect.Descriptor instead.
+func (*CreateJenisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateJenisRequest) GetNamaJenis() string {
+	if x != nil {
+		return x.NamaJenis
+	}
+	return ""
+}
+
+type CreateJenisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Jenis   *Jenis `protobuf:"bytes,3,opt,name=jenis,proto3" json:"jenis,omitempty"`
+}
+
+func (x *CreateJenisResponse) Reset() {
+	*x = CreateJenisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateJenisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJenisResponse) ProtoMessage() {}
+
+func (x *CreateJenisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJenisResponse.ProtoReflect.Descriptor instead.
+func (*CreateJenisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateJenisResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateJenisResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateJenisResponse) GetJenis() *Jenis {
+	if x != nil {
+		return x.Jenis
+	}
+	return nil
+}
+
+type UpdateJenisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdJenis   int64  `protobuf:"varint,1,opt,name=id_jenis,json=idJenis,proto3" json:"id_jenis,omitempty"`
+	NamaJenis string `protobuf:"bytes,2,opt,name=nama_jenis,json=namaJenis,proto3" json:"nama_jenis,omitempty"`
+}
+
+func (x *UpdateJenisRequest) Reset() {
+	*x = UpdateJenisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJenisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJenisRequest) ProtoMessage() {}
+
+func (x *UpdateJenisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJenisRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJenisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateJenisRequest) GetIdJenis() int64 {
+	if x != nil {
+		return x.IdJenis
+	}
+	return 0
+}
+
+func (x *UpdateJenisRequest) GetNamaJenis() string {
+	if x != nil {
+		return x.NamaJenis
+	}
+	return ""
+}
+
+type UpdateJenisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Jenis   *Jenis `protobuf:"bytes,3,opt,name=jenis,proto3" json:"jenis,omitempty"`
+}
+
+func (x *UpdateJenisResponse) Reset() {
+	*x = UpdateJenisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJenisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJenisResponse) ProtoMessage() {}
+
+func (x *UpdateJenisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJenisResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJenisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateJenisResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateJenisResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateJenisResponse) GetJenis() *Jenis {
+	if x != nil {
+		return x.Jenis
+	}
+	return nil
+}
+
+type DeleteJenisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdJenis int64 `protobuf:"varint,1,opt,name=id_jenis,json=idJenis,proto3" json:"id_jenis,omitempty"`
+}
+
+func (x *DeleteJenisRequest) Reset() {
+	*x = DeleteJenisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJenisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJenisRequest) ProtoMessage() {}
+
+func (x *DeleteJenisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJenisRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJenisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteJenisRequest) GetIdJenis() int64 {
+	if x != nil {
+		return x.IdJenis
+	}
+	return 0
+}
+
+type DeleteJenisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteJenisResponse) Reset() {
+	*x = DeleteJenisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJenisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJenisResponse) ProtoMessage() {}
+
+func (x *DeleteJenisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJenisResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJenisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeleteJenisResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteJenisResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Material struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdMaterial   int64  `protobuf:"varint,1,opt,name=id_material,json=idMaterial,proto3" json:"id_material,omitempty"`
+	NamaMaterial string `protobuf:"bytes,2,opt,name=nama_material,json=namaMaterial,proto3" json:"nama_material,omitempty"`
+}
+
+func (x *Material) Reset() {
+	*x = Material{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Material) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Material) ProtoMessage() {}
+
+func (x *Material) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Material.ProtoReflect.Descriptor instead.
+func (*Material) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Material) GetIdMaterial() int64 {
+	if x != nil {
+		return x.IdMaterial
+	}
+	return 0
+}
+
+func (x *Material) GetNamaMaterial() string {
+	if x != nil {
+		return x.NamaMaterial
+	}
+	return ""
+}
+
+type ListMaterialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListMaterialRequest) Reset() {
+	*x = ListMaterialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialRequest) ProtoMessage() {}
+
+func (x *ListMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialRequest.ProtoReflect.Descriptor instead.
+func (*ListMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{72}
+}
+
+type ListMaterialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses []*Material `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *ListMaterialResponse) Reset() {
+	*x = ListMaterialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaterialResponse) ProtoMessage() {}
+
+func (x *ListMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaterialResponse.ProtoReflect.Descriptor instead.
+func (*ListMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListMaterialResponse) GetResponses() []*Material {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type CreateMaterialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamaMaterial string `protobuf:"bytes,1,opt,name=nama_material,json=namaMaterial,proto3" json:"nama_material,omitempty"`
+}
+
+func (x *CreateMaterialRequest) Reset() {
+	*x = CreateMaterialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaterialRequest) ProtoMessage() {}
+
+func (x *CreateMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaterialRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreateMaterialRequest) GetNamaMaterial() string {
+	if x != nil {
+		return x.NamaMaterial
+	}
+	return ""
+}
+
+type CreateMaterialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Material *Material `protobuf:"bytes,3,opt,name=material,proto3" json:"material,omitempty"`
+}
+
+func (x *CreateMaterialResponse) Reset() {
+	*x = CreateMaterialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaterialResponse) ProtoMessage() {}
+
+func (x *CreateMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaterialResponse.ProtoReflect.Descriptor instead.
+func (*CreateMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateMaterialResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateMaterialResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateMaterialResponse) GetMaterial() *Material {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+type UpdateMaterialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdMaterial   int64  `protobuf:"varint,1,opt,name=id_material,json=idMaterial,proto3" json:"id_material,omitempty"`
+	NamaMaterial string `protobuf:"bytes,2,opt,name=nama_material,json=namaMaterial,proto3" json:"nama_material,omitempty"`
+}
+
+func (x *UpdateMaterialRequest) Reset() {
+	*x = UpdateMaterialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaterialRequest) ProtoMessage() {}
+
+func (x *UpdateMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaterialRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateMaterialRequest) GetIdMaterial() int64 {
+	if x != nil {
+		return x.IdMaterial
+	}
+	return 0
+}
+
+func (x *UpdateMaterialRequest) GetNamaMaterial() string {
+	if x != nil {
+		return x.NamaMaterial
+	}
+	return ""
+}
+
+type UpdateMaterialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Material *Material `protobuf:"bytes,3,opt,name=material,proto3" json:"material,omitempty"`
+}
+
+func (x *UpdateMaterialResponse) Reset() {
+	*x = UpdateMaterialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaterialResponse) ProtoMessage() {}
+
+func (x *UpdateMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaterialResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UpdateMaterialResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateMaterialResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateMaterialResponse) GetMaterial() *Material {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+type DeleteMaterialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdMaterial int64 `protobuf:"varint,1,opt,name=id_material,json=idMaterial,proto3" json:"id_material,omitempty"`
+}
+
+func (x *DeleteMaterialRequest) Reset() {
+	*x = DeleteMaterialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaterialRequest) ProtoMessage() {}
+
+func (x *DeleteMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaterialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeleteMaterialRequest) GetIdMaterial() int64 {
+	if x != nil {
+		return x.IdMaterial
+	}
+	return 0
+}
+
+type DeleteMaterialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteMaterialResponse) Reset() {
+	*x = DeleteMaterialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMaterialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaterialResponse) ProtoMessage() {}
+
+func (x *DeleteMaterialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaterialResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMaterialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteMaterialResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteMaterialResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_proto_service_proto protoreflect.FileDescriptor
+
+var file_proto_service_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x72, 0x75, 0x64, 0x1a, 0x1b, 0x62, 0x75, 0x66,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x02, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x0a,
+	0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x0b, 0x66, 0x6f,
+	0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42,
+	0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x05, 0x68,
+	0x61, 0x72, 0x67, 0x61, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x0a, 0x69, 0x64, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x22,
+	0x0a, 0x08, 0x69, 0x64, 0x5f, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x69, 0x64, 0x4a, 0x65, 0x6e,
+	0x69, 0x73, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x0a, 0x69, 0x64, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4a, 0x04, 0x08, 0x01,
+	0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x03,
+	0x61, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f,
+	0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x64,
+	0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x22, 0xc3, 0x02, 0x0a, 0x06, 0x42, 0x61, 0x72, 0x61, 0x6e,
+	0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6b, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x4b,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f,
+	0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6e, 0x61, 0x6d, 0x61, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x08,
+	0x69, 0x64, 0x5f, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x69, 0x64, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x5f,
+	0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x61, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x4d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f,
+	0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6e, 0x61, 0x6d, 0x61, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x38, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x69, 0x64,
+	0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x22, 0x39, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x72,
+	0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x62,
+	0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x2e, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x06, 0x62, 0x61, 0x72, 0x61, 0x6e,
+	0x67, 0x22, 0xe8, 0x03, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x72, 0x61,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x69, 0x64, 0x5f,
+	0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x69, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12,
+	0x29, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0a,
+	0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x0b, 0x66, 0x6f,
+	0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42,
+	0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x05, 0x68,
+	0x61, 0x72, 0x67, 0x61, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+	0x28, 0x00, 0x52, 0x0a, 0x69, 0x64, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x22,
+	0x0a, 0x08, 0x69, 0x64, 0x5f, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x07, 0x69, 0x64, 0x4a, 0x65, 0x6e,
+	0x69, 0x73, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00,
+	0x52, 0x0a, 0x69, 0x64, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x3b, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x3a, 0x80, 0x01, 0xba, 0x48, 0x7d, 0x1a,
+	0x7b, 0x0a, 0x14, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x2e, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1d, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61,
+	0x72, 0x61, 0x6e, 0x67, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x44, 0x21, 0x28, 0x27, 0x6e, 0x61, 0x6d, 0x61, 0x5f,
+	0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x27, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6e, 0x61, 0x6d, 0x61, 0x5f,
+	0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0x22, 0x70, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x61, 0x72, 0x61,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
+	0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x06, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x22, 0x3b,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x61,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x08, 0x69, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x22, 0x4a, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0xc1, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64,
+	0x41, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x64, 0x5f,
+	0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x69, 0x64, 0x4b, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x12, 0x22, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x07,
+	0x69, 0x64, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6d, 0x61,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x69, 0x64, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x12, 0x29, 0x0a, 0x09, 0x68, 0x61, 0x72, 0x67, 0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52,
+	0x08, 0x68, 0x61, 0x72, 0x67, 0x61, 0x4d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x09,
+	0x68, 0x61, 0x72, 0x67, 0x61, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x01, 0x52, 0x08, 0x68, 0x61, 0x72, 0x67,
+	0x61, 0x4d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6e, 0x61, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x68, 0x61, 0x72, 0x67, 0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x68, 0x61, 0x72, 0x67, 0x61, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x8a, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d,
+	0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f,
+	0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x68,
+	0x61, 0x72, 0x67, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x61, 0x72, 0x67,
+	0x61, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x61, 0x4b, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6a,
+	0x65, 0x6e, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x61,
+	0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x22, 0x5e, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x9b, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x93,
+	0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f,
+	0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61,
+	0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x74, 0x6f,
+	0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x61, 0x72,
+	0x67, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x12,
+	0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x61, 0x4b, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x22, 0x5b, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68,
+	0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x95, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x65,
+	0x6e, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65,
+	0x61, 0x64, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x5f,
+	0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x61, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9b, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x57,
+	0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x6f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x68, 0x61, 0x72, 0x67, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x61,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61,
+	0x6d, 0x61, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x5b, 0x0a, 0x14, 0x52, 0x65,
+	0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64,
+	0x57, 0x69, 0x74, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x09, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x8c, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61,
+	0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61,
+	0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x74, 0x6f, 0x5f, 0x62,
+	0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x74,
+	0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x77,
+	0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0xa1, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64,
+	0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4e,
+	0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x16,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62,
+	0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
+	0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6d, 0x6f, 0x72,
+	0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f,
+	0x6d, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x67, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x67, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x74, 0x0a,
+	0x18, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x13,
+	0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x73, 0x4f, 0x66, 0x22, 0x9b, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x8c, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65,
+	0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d,
+	0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f,
+	0x6d, 0x6f, 0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x74, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x6b, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x67, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x67, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x22, 0x91, 0x02, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xc2, 0x1c, 0x28, 0x00, 0x48,
+	0x00, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x30, 0x0a,
+	0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x28, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x69,
+	0x64, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x13,
+	0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x73, 0x4f, 0x66, 0x42, 0x0f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x05, 0xba,
+	0x48, 0x02, 0x08, 0x01, 0x22, 0x45, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x1a,
+	0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x72, 0x61,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61,
+	0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72,
+	0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x42,
+	0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x6d, 0x6f,
+	0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x67,
+	0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x67, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6e,
+	0x61, 0x6d, 0x61, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x61, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x31, 0x0a, 0x1a, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f,
+	0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x34,
+	0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x73, 0x4f, 0x66, 0x22, 0x64, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61,
+	0x72, 0x67, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x12, 0x2a,
+	0x0a, 0x0b, 0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x0a,
+	0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x4e, 0x0a, 0x18, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x72, 0x67, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a,
+	0x07, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x32, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b,
+	0x52, 0x65, 0x66, 0x56, 0x32, 0x52, 0x06, 0x64, 0x61, 0x74, 0x61, 0x56, 0x32, 0x22, 0x8f, 0x01,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52,
+	0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0x76, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x74, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x6f,
+	0x6b, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x70, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6e, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x94, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x56, 0x32, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x64, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x69, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x6b, 0x12, 0x35, 0x0a, 0x08,
+	0x65, 0x78, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x53,
+	0x0a, 0x08, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x2e, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22,
+	0x0a, 0x0d, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x64, 0x52, 0x65, 0x66, 0x42, 0x61, 0x72, 0x61,
+	0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6d, 0x6f, 0x72,
+	0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f,
+	0x6d, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x67, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x67, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x76, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x0d, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x48, 0x00, 0x52, 0x0b, 0x69, 0x64, 0x52, 0x65, 0x66, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12,
+	0x26, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x48, 0x00, 0x52, 0x07,
+	0x6e, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x42, 0x0c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x05,
+	0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x35, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0xf4, 0x01, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0d, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x62, 0x61,
+	0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x64, 0x52, 0x65, 0x66, 0x42, 0x61, 0x72, 0x61,
+	0x6e, 0x67, 0x12, 0x26, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x48,
+	0x00, 0x52, 0x07, 0x6e, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x74,
+	0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28,
+	0x00, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x67, 0x6c, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x67,
+	0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x0c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x05, 0xba, 0x48,
+	0x02, 0x08, 0x01, 0x22, 0x6c, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21,
+	0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x22, 0x79, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0d, 0x69, 0x64, 0x5f, 0x72, 0x65,
+	0x66, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x64, 0x52, 0x65, 0x66,
+	0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10,
+	0x01, 0x18, 0x32, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x42, 0x0c,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x49, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x50, 0x0a, 0x08, 0x4b, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x4b, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6b, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d,
+	0x61, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x2e, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x09, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18,
+	0x64, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x61, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x22,
+	0x78, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a,
+	0x08, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52,
+	0x08, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x22, 0x71, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x0a, 0x69, 0x64, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x0d,
+	0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x0c,
+	0x6e, 0x61, 0x6d, 0x61, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x22, 0x78, 0x0a, 0x16,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x6b, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x2e, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x08, 0x6b, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x22, 0x41, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x69,
+	0x64, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x22, 0x4c, 0x0a, 0x16, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x0a, 0x05, 0x4a, 0x65, 0x6e, 0x69, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x61, 0x6d, 0x61, 0x5f, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x61, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x4a, 0x65,
+	0x6e, 0x69, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x3e,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6a, 0x65, 0x6e,
+	0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10,
+	0x01, 0x18, 0x64, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x61, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x22, 0x6c,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x6a, 0x65, 0x6e,
+	0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
+	0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x05, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x22, 0x62, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x69,
+	0x64, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x28, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6a,
+	0x65, 0x6e, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x61, 0x4a, 0x65, 0x6e, 0x69, 0x73,
+	0x22, 0x6c, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x6a,
+	0x65, 0x6e, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x72, 0x75,
+	0x64, 0x2e, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x05, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x22, 0x38,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x6a, 0x65, 0x6e, 0x69, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x07, 0x69, 0x64, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x50, 0x0a, 0x08, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x61, 0x4d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x4d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0d, 0x6e,
+	0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x0c, 0x6e,
+	0x61, 0x6d, 0x61, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x78, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x6d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x08, 0x6d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x71, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x69, 0x64,
+	0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61,
+	0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x61,
+	0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x78, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
+	0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x22, 0x41, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x69,
+	0x64, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x69, 0x64, 0x4d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x4c, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x32, 0x9b, 0x0c, 0x0a, 0x0b, 0x43, 0x72, 0x75, 0x64, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x19, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x72, 0x61, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x12,
+	0x14, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10,
+	0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x1d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x65, 0x6e, 0x69, 0x73,
+	0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68,
+	0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x65, 0x6e, 0x69,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x52, 0x65, 0x61,
+	0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1d, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d,
+	0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61,
+	0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61,
+	0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x14,
+	0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61,
+	0x72, 0x61, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72,
+	0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x52,
+	0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x72, 0x61, 0x6e,
+	0x67, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6c,
+	0x6c, 0x12, 0x16, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x30, 0x01, 0x12,
+	0x54, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e,
+	0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12,
+	0x23, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x6f, 0x74,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61,
+	0x72, 0x67, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x72, 0x67, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x72, 0x67, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x66, 0x12, 0x13,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b,
+	0x52, 0x65, 0x66, 0x1a, 0x17, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x39,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x63, 0x72, 0x75,
+	0x64, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0xf8, 0x06, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x73, 0x69, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12,
+	0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x1b, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x65, 0x6e, 0x69,
+	0x73, 0x12, 0x16, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x65, 0x6e,
+	0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69,
+	0x73, 0x12, 0x18, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a,
+	0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x65, 0x6e,
+	0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x19,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_service_proto_rawDescOnce sync.Once
+	file_proto_service_proto_rawDescData = file_proto_service_proto_rawDesc
+)
+
+func file_proto_service_proto_rawDescGZIP() []byte {
+	file_proto_service_proto_rawDescOnce.Do(func() {
+		file_proto_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_service_proto_rawDescData)
+	})
+	return file_proto_service_proto_rawDescData
+}
+
+var file_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_proto_service_proto_goTypes = []interface{}{
+	(*CreateRequest)(nil),                 // 0: crud.CreateRequest
+	(*CreateResponse)(nil),                // 1: crud.CreateResponse
+	(*Barang)(nil),                        // 2: crud.Barang
+	(*GetBarangRequest)(nil),              // 3: crud.GetBarangRequest
+	(*GetBarangResponse)(nil),             // 4: crud.GetBarangResponse
+	(*UpdateBarangRequest)(nil),           // 5: crud.UpdateBarangRequest
+	(*UpdateBarangResponse)(nil),          // 6: crud.UpdateBarangResponse
+	(*DeleteBarangRequest)(nil),           // 7: crud.DeleteBarangRequest
+	(*DeleteBarangResponse)(nil),          // 8: crud.DeleteBarangResponse
+	(*ReadAllRequest)(nil),                // 9: crud.ReadAllRequest
+	(*ReadAllFilter)(nil),                 // 10: crud.ReadAllFilter
+	(*ReadAllResponse)(nil),               // 11: crud.ReadAllResponse
+	(*ResponseRead)(nil),                  // 12: crud.ResponseRead
+	(*ReadWithCategoryRequest)(nil),       // 13: crud.ReadWithCategoryRequest
+	(*ReadWithCategoryResponse)(nil),      // 14: crud.ReadWithCategoryResponse
+	(*ResponseReadCategory)(nil),          // 15: crud.ResponseReadCategory
+	(*ReadWithJenisRequest)(nil),          // 16: crud.ReadWithJenisRequest
+	(*ReadWithJenisResponse)(nil),         // 17: crud.ReadWithJenisResponse
+	(*ResponseReadJenis)(nil),             // 18: crud.ResponseReadJenis
+	(*ReadWithMaterialRequest)(nil),       // 19: crud.ReadWithMaterialRequest
+	(*ReadWithMaterialResponse)(nil),      // 20: crud.ReadWithMaterialResponse
+	(*ResponseReadMaterial)(nil),          // 21: crud.ResponseReadMaterial
+	(*ReadWithBatchRequest)(nil),          // 22: crud.ReadWithBatchRequest
+	(*ReadWithBatchResponse)(nil),         // 23: crud.ReadWithBatchResponse
+	(*ResponseReadBatch)(nil),             // 24: crud.ResponseReadBatch
+	(*ReadNotExpiredBarangRequest)(nil),   // 25: crud.ReadNotExpiredBarangRequest
+	(*ReadNotExpiredBarangResponse)(nil),  // 26: crud.ReadNotExpiredBarangResponse
+	(*ResponseReadNotExpired)(nil),        // 27: crud.ResponseReadNotExpired
+	(*ReadExpiredBarangRequest)(nil),      // 28: crud.ReadExpiredBarangRequest
+	(*ReadExpiredBarangResponse)(nil),     // 29: crud.ReadExpiredBarangResponse
+	(*ResponseReadExpired)(nil),           // 30: crud.ResponseReadExpired
+	(*ReadExpiringBarangRequest)(nil),     // 31: crud.ReadExpiringBarangRequest
+	(*DateRange)(nil),                     // 32: crud.DateRange
+	(*ReadExpiringBarangResponse)(nil),    // 33: crud.ReadExpiringBarangResponse
+	(*ResponseReadExpiring)(nil),          // 34: crud.ResponseReadExpiring
+	(*StreamAllRequest)(nil),              // 35: crud.StreamAllRequest
+	(*StreamExpiredBarangRequest)(nil),    // 36: crud.StreamExpiredBarangRequest
+	(*StreamNotExpiredBarangRequest)(nil), // 37: crud.StreamNotExpiredBarangRequest
+	(*UpdateHargaBatchRequest)(nil),       // 38: crud.UpdateHargaBatchRequest
+	(*UpdateHargaBatchResponse)(nil),      // 39: crud.UpdateHargaBatchResponse
+	(*CreateBulkRefRequest)(nil),          // 40: crud.CreateBulkRefRequest
+	(*CreateBulkRefResponse)(nil),         // 41: crud.CreateBulkRefResponse
+	(*CreateBulkRef)(nil),                 // 42: crud.CreateBulkRef
+	(*CreateBulkRefV2)(nil),               // 43: crud.CreateBulkRefV2
+	(*RowError)(nil),                      // 44: crud.RowError
+	(*ImportRefResponse)(nil),             // 45: crud.ImportRefResponse
+	(*Batch)(nil),                         // 46: crud.Batch
+	(*GetBatchRequest)(nil),               // 47: crud.GetBatchRequest
+	(*GetBatchResponse)(nil),              // 48: crud.GetBatchResponse
+	(*UpdateBatchRequest)(nil),            // 49: crud.UpdateBatchRequest
+	(*UpdateBatchResponse)(nil),           // 50: crud.UpdateBatchResponse
+	(*DeleteBatchRequest)(nil),            // 51: crud.DeleteBatchRequest
+	(*DeleteBatchResponse)(nil),           // 52: crud.DeleteBatchResponse
+	(*Kategori)(nil),                      // 53: crud.Kategori
+	(*ListKategoriRequest)(nil),           // 54: crud.ListKategoriRequest
+	(*ListKategoriResponse)(nil),          // 55: crud.ListKategoriResponse
+	(*CreateKategoriRequest)(nil),         // 56: crud.CreateKategoriRequest
+	(*CreateKategoriResponse)(nil),        // 57: crud.CreateKategoriResponse
+	(*UpdateKategoriRequest)(nil),         // 58: crud.UpdateKategoriRequest
+	(*UpdateKategoriResponse)(nil),        // 59: crud.UpdateKategoriResponse
+	(*DeleteKategoriRequest)(nil),         // 60: crud.DeleteKategoriRequest
+	(*DeleteKategoriResponse)(nil),        // 61: crud.DeleteKategoriResponse
+	(*Jenis)(nil),                         // 62: crud.Jenis
+	(*ListJenisRequest)(nil),              // 63: crud.ListJenisRequest
+	(*ListJenisResponse)(nil),             // 64: crud.ListJenisResponse
+	(*CreateJenisRequest)(nil),            // 65: crud.CreateJenisRequest
+	(*CreateJenisResponse)(nil),           // 66: crud.CreateJenisResponse
+	(*UpdateJenisRequest)(nil),            // 67: crud.UpdateJenisRequest
+	(*UpdateJenisResponse)(nil),           // 68: crud.UpdateJenisResponse
+	(*DeleteJenisRequest)(nil),            // 69: crud.DeleteJenisRequest
+	(*DeleteJenisResponse)(nil),           // 70: crud.DeleteJenisResponse
+	(*Material)(nil),                      // 71: crud.Material
+	(*ListMaterialRequest)(nil),           // 72: crud.ListMaterialRequest
+	(*ListMaterialResponse)(nil),          // 73: crud.ListMaterialResponse
+	(*CreateMaterialRequest)(nil),         // 74: crud.CreateMaterialRequest
+	(*CreateMaterialResponse)(nil),        // 75: crud.CreateMaterialResponse
+	(*UpdateMaterialRequest)(nil),         // 76: crud.UpdateMaterialRequest
+	(*UpdateMaterialResponse)(nil),        // 77: crud.UpdateMaterialResponse
+	(*DeleteMaterialRequest)(nil),         // 78: crud.DeleteMaterialRequest
+	(*DeleteMaterialResponse)(nil),        // 79: crud.DeleteMaterialResponse
+	(*fieldmaskpb.FieldMask)(nil),         // 80: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),         // 81: google.protobuf.Timestamp
+}
+var file_proto_service_proto_depIdxs = []int32{
+	2,  // 0: crud.GetBarangResponse.barang:type_name -> crud.Barang
+	80, // 1: crud.UpdateBarangRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 2: crud.UpdateBarangResponse.barang:type_name -> crud.Barang
+	10, // 3: crud.ReadAllRequest.filter:type_name -> crud.ReadAllFilter
+	12, // 4: crud.ReadAllResponse.responses:type_name -> crud.ResponseRead
+	15, // 5: crud.ReadWithCategoryResponse.responses:type_name -> crud.ResponseReadCategory
+	18, // 6: crud.ReadWithJenisResponse.responses:type_name -> crud.ResponseReadJenis
+	21, // 7: crud.ReadWithMaterialResponse.responses:type_name -> crud.ResponseReadMaterial
+	24, // 8: crud.ReadWithBatchResponse.responses:type_name -> crud.ResponseReadBatch
+	27, // 9: crud.ReadNotExpiredBarangResponse.responses:type_name -> crud.ResponseReadNotExpired
+	30, // 10: crud.ReadExpiredBarangResponse.responses:type_name -> crud.ResponseReadExpired
+	32, // 11: crud.ReadExpiringBarangRequest.date_range:type_name -> crud.DateRange
+	34, // 12: crud.ReadExpiringBarangResponse.responses:type_name -> crud.ResponseReadExpiring
+	42, // 13: crud.CreateBulkRefRequest.data:type_name -> crud.CreateBulkRef
+	43, // 14: crud.CreateBulkRefRequest.data_v2:type_name -> crud.CreateBulkRefV2
+	44, // 15: crud.CreateBulkRefResponse.errors:type_name -> crud.RowError
+	81, // 16: crud.CreateBulkRefV2.exp_date:type_name -> google.protobuf.Timestamp
+	44, // 17: crud.ImportRefResponse.errors:type_name -> crud.RowError
+	46, // 18: crud.GetBatchResponse.batch:type_name -> crud.Batch
+	80, // 19: crud.UpdateBatchRequest.update_mask:type_name -> google.protobuf.FieldMask
+	46, // 20: crud.UpdateBatchResponse.batch:type_name -> crud.Batch
+	53, // 21: crud.ListKategoriResponse.responses:type_name -> crud.Kategori
+	53, // 22: crud.CreateKategoriResponse.kategori:type_name -> crud.Kategori
+	53, // 23: crud.UpdateKategoriResponse.kategori:type_name -> crud.Kategori
+	62, // 24: crud.ListJenisResponse.responses:type_name -> crud.Jenis
+	62, // 25: crud.CreateJenisResponse.jenis:type_name -> crud.Jenis
+	62, // 26: crud.UpdateJenisResponse.jenis:type_name -> crud.Jenis
+	71, // 27: crud.ListMaterialResponse.responses:type_name -> crud.Material
+	71, // 28: crud.CreateMaterialResponse.material:type_name -> crud.Material
+	71, // 29: crud.UpdateMaterialResponse.material:type_name -> crud.Material
+	0,  // 30: crud.CrudService.Create:input_type -> crud.CreateRequest
+	3,  // 31: crud.CrudService.GetBarang:input_type -> crud.GetBarangRequest
+	5,  // 32: crud.CrudService.UpdateBarang:input_type -> crud.UpdateBarangRequest
+	7,  // 33: crud.CrudService.DeleteBarang:input_type -> crud.DeleteBarangRequest
+	9,  // 34: crud.CrudService.ReadAll:input_type -> crud.ReadAllRequest
+	13, // 35: crud.CrudService.ReadWithCategory:input_type -> crud.ReadWithCategoryRequest
+	16, // 36: crud.CrudService.ReadWithJenis:input_type -> crud.ReadWithJenisRequest
+	19, // 37: crud.CrudService.ReadWithMaterial:input_type -> crud.ReadWithMaterialRequest
+	22, // 38: crud.CrudService.ReadWithBatch:input_type -> crud.ReadWithBatchRequest
+	28, // 39: crud.CrudService.ReadExpiredBarang:input_type -> crud.ReadExpiredBarangRequest
+	25, // 40: crud.CrudService.ReadNotExpiredBarang:input_type -> crud.ReadNotExpiredBarangRequest
+	31, // 41: crud.CrudService.ReadExpiringBarang:input_type -> crud.ReadExpiringBarangRequest
+	35, // 42: crud.CrudService.StreamAll:input_type -> crud.StreamAllRequest
+	36, // 43: crud.CrudService.StreamExpiredBarang:input_type -> crud.StreamExpiredBarangRequest
+	37, // 44: crud.CrudService.StreamNotExpiredBarang:input_type -> crud.StreamNotExpiredBarangRequest
+	38, // 45: crud.CrudService.UpdateHargaBatch:input_type -> crud.UpdateHargaBatchRequest
+	40, // 46: crud.CrudService.CreateBulkRef:input_type -> crud.CreateBulkRefRequest
+	42, // 47: crud.CrudService.ImportRef:input_type -> crud.CreateBulkRef
+	47, // 48: crud.CrudService.GetBatch:input_type -> crud.GetBatchRequest
+	49, // 49: crud.CrudService.UpdateBatch:input_type -> crud.UpdateBatchRequest
+	51, // 50: crud.CrudService.DeleteBatch:input_type -> crud.DeleteBatchRequest
+	54, // 51: crud.ReferensiService.ListKategori:input_type -> crud.ListKategoriRequest
+	56, // 52: crud.ReferensiService.CreateKategori:input_type -> crud.CreateKategoriRequest
+	58, // 53: crud.ReferensiService.UpdateKategori:input_type -> crud.UpdateKategoriRequest
+	60, // 54: crud.ReferensiService.DeleteKategori:input_type -> crud.DeleteKategoriRequest
+	63, // 55: crud.ReferensiService.ListJenis:input_type -> crud.ListJenisRequest
+	65, // 56: crud.ReferensiService.CreateJenis:input_type -> crud.CreateJenisRequest
+	67, // 57: crud.ReferensiService.UpdateJenis:input_type -> crud.UpdateJenisRequest
+	69, // 58: crud.ReferensiService.DeleteJenis:input_type -> crud.DeleteJenisRequest
+	72, // 59: crud.ReferensiService.ListMaterial:input_type -> crud.ListMaterialRequest
+	74, // 60: crud.ReferensiService.CreateMaterial:input_type -> crud.CreateMaterialRequest
+	76, // 61: crud.ReferensiService.UpdateMaterial:input_type -> crud.UpdateMaterialRequest
+	78, // 62: crud.ReferensiService.DeleteMaterial:input_type -> crud.DeleteMaterialRequest
+	1,  // 63: crud.CrudService.Create:output_type -> crud.CreateResponse
+	4,  // 64: crud.CrudService.GetBarang:output_type -> crud.GetBarangResponse
+	6,  // 65: crud.CrudService.UpdateBarang:output_type -> crud.UpdateBarangResponse
+	8,  // 66: crud.CrudService.DeleteBarang:output_type -> crud.DeleteBarangResponse
+	11, // 67: crud.CrudService.ReadAll:output_type -> crud.ReadAllResponse
+	14, // 68: crud.CrudService.ReadWithCategory:output_type -> crud.ReadWithCategoryResponse
+	17, // 69: crud.CrudService.ReadWithJenis:output_type -> crud.ReadWithJenisResponse
+	20, // 70: crud.CrudService.ReadWithMaterial:output_type -> crud.ReadWithMaterialResponse
+	23, // 71: crud.CrudService.ReadWithBatch:output_type -> crud.ReadWithBatchResponse
+	29, // 72: crud.CrudService.ReadExpiredBarang:output_type -> crud.ReadExpiredBarangResponse
+	26, // 73: crud.CrudService.ReadNotExpiredBarang:output_type -> crud.ReadNotExpiredBarangResponse
+	33, // 74: crud.CrudService.ReadExpiringBarang:output_type -> crud.ReadExpiringBarangResponse
+	12, // 75: crud.CrudService.StreamAll:output_type -> crud.ResponseRead
+	30, // 76: crud.CrudService.StreamExpiredBarang:output_type -> crud.ResponseReadExpired
+	27, // 77: crud.CrudService.StreamNotExpiredBarang:output_type -> crud.ResponseReadNotExpired
+	39, // 78: crud.CrudService.UpdateHargaBatch:output_type -> crud.UpdateHargaBatchResponse
+	41, // 79: crud.CrudService.CreateBulkRef:output_type -> crud.CreateBulkRefResponse
+	45, // 80: crud.CrudService.ImportRef:output_type -> crud.ImportRefResponse
+	48, // 81: crud.CrudService.GetBatch:output_type -> crud.GetBatchResponse
+	50, // 82: crud.CrudService.UpdateBatch:output_type -> crud.UpdateBatchResponse
+	52, // 83: crud.CrudService.DeleteBatch:output_type -> crud.DeleteBatchResponse
+	55, // 84: crud.ReferensiService.ListKategori:output_type -> crud.ListKategoriResponse
+	57, // 85: crud.ReferensiService.CreateKategori:output_type -> crud.CreateKategoriResponse
+	59, // 86: crud.ReferensiService.UpdateKategori:output_type -> crud.UpdateKategoriResponse
+	61, // 87: crud.ReferensiService.DeleteKategori:output_type -> crud.DeleteKategoriResponse
+	64, // 88: crud.ReferensiService.ListJenis:output_type -> crud.ListJenisResponse
+	66, // 89: crud.ReferensiService.CreateJenis:output_type -> crud.CreateJenisResponse
+	68, // 90: crud.ReferensiService.UpdateJenis:output_type -> crud.UpdateJenisResponse
+	70, // 91: crud.ReferensiService.DeleteJenis:output_type -> crud.DeleteJenisResponse
+	73, // 92: crud.ReferensiService.ListMaterial:output_type -> crud.ListMaterialResponse
+	75, // 93: crud.ReferensiService.CreateMaterial:output_type -> crud.CreateMaterialResponse
+	77, // 94: crud.ReferensiService.UpdateMaterial:output_type -> crud.UpdateMaterialResponse
+	79, // 95: crud.ReferensiService.DeleteMaterial:output_type -> crud.DeleteMaterialResponse
+	63, // [63:96] is the sub-list for method output_type
+	30, // [30:63] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_proto_service_proto_init() }
+func file_proto_service_proto_init() {
+	if File_proto_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Barang); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBarangRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBarangResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBarangRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBarangResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBarangRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBarangResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAllResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseRead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWithCategoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWithCategoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReadCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWithJenisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWithJenisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReadJenis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWithMaterialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWithMaterialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReadMaterial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWithBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWithBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReadBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadNotExpiredBarangRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadNotExpiredBarangResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReadNotExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadExpiredBarangRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadExpiredBarangResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReadExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadExpiringBarangRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadExpiringBarangResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReadExpiring); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamExpiredBarangRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamNotExpiredBarangRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHargaBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHargaBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBulkRefRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBulkRefResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBulkRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBulkRefV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RowError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRefResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Batch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateBulkRef.ProtoReflect.Descriptor instead.
-func (*CreateBulkRef) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *CreateBulkRef) GetIdBarang() string {
-	if x != nil {
-		return x.IdBarang
-	}
-	return ""
-}
-
-func (x *CreateBulkRef) GetStok() string {
-	if x != nil {
-		return x.Stok
-	}
-	return ""
-}
-
-func (x *CreateBulkRef) GetExpDate() string {
-	if x != nil {
-		return x.ExpDate
-	}
-	return ""
-}
-
-func (x *CreateBulkRef) GetNoBatch() string {
-	if x != nil {
-		return x.NoBatch
-	}
-	return ""
-}
-
-var File_proto_service_proto protoreflect.FileDescriptor
-
-var file_proto_service_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x72, 0x75, 0x64, 0x22, 0x35, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61,
-	0x67, 0x65, 0x22, 0x44, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64,
-	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x0f, 0x52, 0x65,
-	0x61, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
-	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22,
-	0xc5, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e,
-	0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61,
-	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61,
-	0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6e, 0x61, 0x6d, 0x61, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6a, 0x65, 0x6e, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x61, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x19, 0x0a, 0x08,
-	0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6e, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x57,
-	0x69, 0x74, 0x68, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x54, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
-	0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x65, 0x61, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61,
-	0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72,
-	0x61, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d,
-	0x61, 0x5f, 0x6b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x61, 0x4b, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x22, 0x16,
-	0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69,
-	0x74, 0x68, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x35, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a,
-	0x0b, 0x66, 0x6f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x14,
-	0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68,
-	0x61, 0x72, 0x67, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6a, 0x65, 0x6e,
-	0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x61, 0x4a, 0x65,
-	0x6e, 0x69, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d,
-	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54,
-	0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61,
-	0x64, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f,
-	0x0a, 0x0b, 0x66, 0x6f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12,
-	0x14, 0x0a, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x68, 0x61, 0x72, 0x67, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x61, 0x5f, 0x6d, 0x61,
-	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x61,
-	0x6d, 0x61, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65,
-	0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65,
-	0x61, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x65, 0x61, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61,
-	0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
-	0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x74,
-	0x6f, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x66, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x61,
-	0x72, 0x67, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x5a, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x64, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x8f, 0x01, 0x0a,
-	0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61, 0x5f,
-	0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61,
-	0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6d, 0x6f,
-	0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
-	0x6f, 0x6d, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x6b, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x67, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x74, 0x67, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x1a,
-	0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72,
-	0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x19, 0x52, 0x65,
-	0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x72, 0x75,
-	0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x64, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
-	0x22, 0x8c, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x61,
-	0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x61,
-	0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
-	0x61, 0x6d, 0x61, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6d,
-	0x6f, 0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74,
-	0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x6b, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x67, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x67, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22,
-	0x50, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x72, 0x67, 0x61, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x61,
-	0x72, 0x67, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x61, 0x72, 0x67, 0x61,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x6d, 0x6f, 0x72, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x22, 0x4e, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x72, 0x67, 0x61,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x3f, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52,
-	0x65, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x4b, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b,
-	0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x76, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x62, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x74, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x6f,
-	0x6b, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x70, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6e, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x32, 0x86, 0x06, 0x0a, 0x0b, 0x43, 0x72, 0x75, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x12, 0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07,
-	0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x57,
-	0x69, 0x74, 0x68, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x57, 0x69, 0x74, 0x68, 0x4a, 0x65, 0x6e, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x51, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74, 0x68,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x74,
-	0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
-	0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72,
-	0x61, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x63,
-	0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x61, 0x72, 0x61, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x72,
-	0x67, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x72, 0x67, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x72, 0x67, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x0c, 0x5a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_service_proto_rawDescOnce sync.Once
-	file_proto_service_proto_rawDescData = file_proto_service_proto_rawDesc
-)
-
-func file_proto_service_proto_rawDescGZIP() []byte {
-	file_proto_service_proto_rawDescOnce.Do(func() {
-		file_proto_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_service_proto_rawDescData)
-	})
-	return file_proto_service_proto_rawDescData
-}
-
-var file_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
-var file_proto_service_proto_goTypes = []interface{}{
-	(*CreateRequest)(nil),                // 0: crud.CreateRequest
-	(*CreateResponse)(nil),               // 1: crud.CreateResponse
-	(*ReadAllRequest)(nil),               // 2: crud.ReadAllRequest
-	(*ReadAllResponse)(nil),              // 3: crud.ReadAllResponse
-	(*ResponseRead)(nil),                 // 4: crud.ResponseRead
-	(*ReadWithCategoryRequest)(nil),      // 5: crud.ReadWithCategoryRequest
-	(*ReadWithCategoryResponse)(nil),     // 6: crud.ReadWithCategoryResponse
-	(*ResponseReadCategory)(nil),         // 7: crud.ResponseReadCategory
-	(*ReadWithJenisRequest)(nil),         // 8: crud.ReadWithJenisRequest
-	(*ReadWithJenisResponse)(nil),        // 9: crud.ReadWithJenisResponse
-	(*ResponseReadJenis)(nil),            // 10: crud.ResponseReadJenis
-	(*ReadWithMaterialRequest)(nil),      // 11: crud.ReadWithMaterialRequest
-	(*ReadWithMaterialResponse)(nil),     // 12: crud.ReadWithMaterialResponse
-	(*ResponseReadMaterial)(nil),         // 13: crud.ResponseReadMaterial
-	(*ReadWithBatchRequest)(nil),         // 14: crud.ReadWithBatchRequest
-	(*ReadWithBatchResponse)(nil),        // 15: crud.ReadWithBatchResponse
-	(*ResponseReadBatch)(nil),            // 16: crud.ResponseReadBatch
-	(*ReadNotExpiredBarangRequest)(nil),  // 17: crud.ReadNotExpiredBarangRequest
-	(*ReadNotExpiredBarangResponse)(nil), // 18: crud.ReadNotExpiredBarangResponse
-	(*ResponseReadNotExpired)(nil),       // 19: crud.ResponseReadNotExpired
-	(*ReadExpiredBarangRequest)(nil),     // 20: crud.ReadExpiredBarangRequest
-	(*ReadExpiredBarangResponse)(nil),    // 21: crud.ReadExpiredBarangResponse
-	(*ResponseReadExpired)(nil),          // 22: crud.ResponseReadExpired
-	(*UpdateHargaBatchRequest)(nil),      // 23: crud.UpdateHargaBatchRequest
-	(*UpdateHargaBatchResponse)(nil),     // 24: crud.UpdateHargaBatchResponse
-	(*CreateBulkRefRequest)(nil),         // 25: crud.CreateBulkRefRequest
-	(*CreateBulkRefResponse)(nil),        // 26: crud.CreateBulkRefResponse
-	(*CreateBulkRef)(nil),                // 27: crud.CreateBulkRef
-}
-var file_proto_service_proto_depIdxs = []int32{
-	4,  // 0: crud.ReadAllResponse.responses:type_name -> crud.ResponseRead
-	7,  // 1: crud.ReadWithCategoryResponse.responses:type_name -> crud.ResponseReadCategory
-	10, // 2: crud.ReadWithJenisResponse.responses:type_name -> crud.ResponseReadJenis
-	13, // 3: crud.ReadWithMaterialResponse.responses:type_name -> crud.ResponseReadMaterial
-	16, // 4: crud.ReadWithBatchResponse.responses:type_name -> crud.ResponseReadBatch
-	19, // 5: crud.ReadNotExpiredBarangResponse.responses:type_name -> crud.ResponseReadNotExpired
-	22, // 6: crud.ReadExpiredBarangResponse.responses:type_name -> crud.ResponseReadExpired
-	27, // 7: crud.CreateBulkRefRequest.data:type_name -> crud.CreateBulkRef
-	0,  // 8: crud.CrudService.Create:input_type -> crud.CreateRequest
-	2,  // 9: crud.CrudService.ReadAll:input_type -> crud.ReadAllRequest
-	5,  // 10: crud.CrudService.ReadWithCategory:input_type -> crud.ReadWithCategoryRequest
-	8,  // 11: crud.CrudService.ReadWithJenis:input_type -> crud.ReadWithJenisRequest
-	11, // 12: crud.CrudService.ReadWithMaterial:input_type -> crud.ReadWithMaterialRequest
-	14, // 13: crud.CrudService.ReadWithBatch:input_type -> crud.ReadWithBatchRequest
-	20, // 14: crud.CrudService.ReadExpiredBarang:input_type -> crud.ReadExpiredBarangRequest
-	17, // 15: crud.CrudService.ReadNotExpiredBarang:input_type -> crud.ReadNotExpiredBarangRequest
-	23, // 16: crud.CrudService.UpdateHargaBatch:input_type -> crud.UpdateHargaBatchRequest
-	25, // 17: crud.CrudService.CreateBulkRef:input_type -> crud.CreateBulkRefRequest
-	1,  // 18: crud.CrudService.Create:output_type -> crud.CreateResponse
-	3,  // 19: crud.CrudService.ReadAll:output_type -> crud.ReadAllResponse
-	6,  // 20: crud.CrudService.ReadWithCategory:output_type -> crud.ReadWithCategoryResponse
-	9,  // 21: crud.CrudService.ReadWithJenis:output_type -> crud.ReadWithJenisResponse
-	12, // 22: crud.CrudService.ReadWithMaterial:output_type -> crud.ReadWithMaterialResponse
-	15, // 23: crud.CrudService.ReadWithBatch:output_type -> crud.ReadWithBatchResponse
-	21, // 24: crud.CrudService.ReadExpiredBarang:output_type -> crud.ReadExpiredBarangResponse
-	18, // 25: crud.CrudService.ReadNotExpiredBarang:output_type -> crud.ReadNotExpiredBarangResponse
-	24, // 26: crud.CrudService.UpdateHargaBatch:output_type -> crud.UpdateHargaBatchResponse
-	26, // 27: crud.CrudService.CreateBulkRef:output_type -> crud.CreateBulkRefResponse
-	18, // [18:28] is the sub-list for method output_type
-	8,  // [8:18] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
-}
-
-func init() { file_proto_service_proto_init() }
-func file_proto_service_proto_init() {
-	if File_proto_service_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRequest); i {
+		file_proto_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1826,8 +6650,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResponse); i {
+		file_proto_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1838,8 +6662,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAllRequest); i {
+		file_proto_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1850,8 +6674,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAllResponse); i {
+		file_proto_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1862,8 +6686,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseRead); i {
+		file_proto_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Kategori); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1874,8 +6698,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadWithCategoryRequest); i {
+		file_proto_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKategoriRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1886,8 +6710,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadWithCategoryResponse); i {
+		file_proto_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKategoriResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1898,8 +6722,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseReadCategory); i {
+		file_proto_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKategoriRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1910,8 +6734,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadWithJenisRequest); i {
+		file_proto_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKategoriResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1922,8 +6746,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadWithJenisResponse); i {
+		file_proto_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateKategoriRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1934,8 +6758,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseReadJenis); i {
+		file_proto_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateKategoriResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1946,8 +6770,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadWithMaterialRequest); i {
+		file_proto_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteKategoriRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1958,8 +6782,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadWithMaterialResponse); i {
+		file_proto_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteKategoriResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1970,8 +6794,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseReadMaterial); i {
+		file_proto_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Jenis); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1982,8 +6806,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadWithBatchRequest); i {
+		file_proto_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJenisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1994,8 +6818,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadWithBatchResponse); i {
+		file_proto_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJenisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2006,8 +6830,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseReadBatch); i {
+		file_proto_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJenisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2018,8 +6842,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadNotExpiredBarangRequest); i {
+		file_proto_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJenisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2030,8 +6854,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadNotExpiredBarangResponse); i {
+		file_proto_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJenisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2042,8 +6866,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseReadNotExpired); i {
+		file_proto_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJenisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2054,8 +6878,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadExpiredBarangRequest); i {
+		file_proto_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJenisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2066,8 +6890,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadExpiredBarangResponse); i {
+		file_proto_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJenisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2078,8 +6902,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseReadExpired); i {
+		file_proto_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Material); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2090,8 +6914,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateHargaBatchRequest); i {
+		file_proto_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaterialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2102,8 +6926,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateHargaBatchResponse); i {
+		file_proto_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaterialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2114,8 +6938,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkRefRequest); i {
+		file_proto_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMaterialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2126,8 +6950,8 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkRefResponse); i {
+		file_proto_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMaterialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2138,8 +6962,44 @@ func file_proto_service_proto_init() {
 				return nil
 			}
 		}
-		file_proto_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkRef); i {
+		file_proto_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMaterialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMaterialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMaterialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMaterialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2151,15 +7011,32 @@ func file_proto_service_proto_init() {
 			}
 		}
 	}
+	file_proto_service_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_proto_service_proto_msgTypes[31].OneofWrappers = []interface{}{
+		(*ReadExpiringBarangRequest_WithinDays)(nil),
+		(*ReadExpiringBarangRequest_DateRange)(nil),
+	}
+	file_proto_service_proto_msgTypes[47].OneofWrappers = []interface{}{
+		(*GetBatchRequest_IdRefBarang)(nil),
+		(*GetBatchRequest_NoBatch)(nil),
+	}
+	file_proto_service_proto_msgTypes[49].OneofWrappers = []interface{}{
+		(*UpdateBatchRequest_IdRefBarang)(nil),
+		(*UpdateBatchRequest_NoBatch)(nil),
+	}
+	file_proto_service_proto_msgTypes[51].OneofWrappers = []interface{}{
+		(*DeleteBatchRequest_IdRefBarang)(nil),
+		(*DeleteBatchRequest_NoBatch)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   80,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_service_proto_goTypes,
 		DependencyIndexes: file_proto_service_proto_depIdxs,
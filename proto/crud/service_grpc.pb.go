@@ -23,6 +23,9 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CrudServiceClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	GetBarang(ctx context.Context, in *GetBarangRequest, opts ...grpc.CallOption) (*GetBarangResponse, error)
+	UpdateBarang(ctx context.Context, in *UpdateBarangRequest, opts ...grpc.CallOption) (*UpdateBarangResponse, error)
+	DeleteBarang(ctx context.Context, in *DeleteBarangRequest, opts ...grpc.CallOption) (*DeleteBarangResponse, error)
 	ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error)
 	ReadWithCategory(ctx context.Context, in *ReadWithCategoryRequest, opts ...grpc.CallOption) (*ReadWithCategoryResponse, error)
 	ReadWithJenis(ctx context.Context, in *ReadWithJenisRequest, opts ...grpc.CallOption) (*ReadWithJenisResponse, error)
@@ -30,10 +33,21 @@ type CrudServiceClient interface {
 	ReadWithBatch(ctx context.Context, in *ReadWithBatchRequest, opts ...grpc.CallOption) (*ReadWithBatchResponse, error)
 	ReadExpiredBarang(ctx context.Context, in *ReadExpiredBarangRequest, opts ...grpc.CallOption) (*ReadExpiredBarangResponse, error)
 	ReadNotExpiredBarang(ctx context.Context, in *ReadNotExpiredBarangRequest, opts ...grpc.CallOption) (*ReadNotExpiredBarangResponse, error)
+	ReadExpiringBarang(ctx context.Context, in *ReadExpiringBarangRequest, opts ...grpc.CallOption) (*ReadExpiringBarangResponse, error)
+	// streaming variants for exports, one row per message
+	StreamAll(ctx context.Context, in *StreamAllRequest, opts ...grpc.CallOption) (CrudService_StreamAllClient, error)
+	StreamExpiredBarang(ctx context.Context, in *StreamExpiredBarangRequest, opts ...grpc.CallOption) (CrudService_StreamExpiredBarangClient, error)
+	StreamNotExpiredBarang(ctx context.Context, in *StreamNotExpiredBarangRequest, opts ...grpc.CallOption) (CrudService_StreamNotExpiredBarangClient, error)
 	// logika update
 	UpdateHargaBatch(ctx context.Context, in *UpdateHargaBatchRequest, opts ...grpc.CallOption) (*UpdateHargaBatchResponse, error)
 	// logika create bulk
 	CreateBulkRef(ctx context.Context, in *CreateBulkRefRequest, opts ...grpc.CallOption) (*CreateBulkRefResponse, error)
+	// streaming import of ref_barang, committed in chunks
+	ImportRef(ctx context.Context, opts ...grpc.CallOption) (CrudService_ImportRefClient, error)
+	// per-batch maintenance of ref_barang
+	GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchResponse, error)
+	UpdateBatch(ctx context.Context, in *UpdateBatchRequest, opts ...grpc.CallOption) (*UpdateBatchResponse, error)
+	DeleteBatch(ctx context.Context, in *DeleteBatchRequest, opts ...grpc.CallOption) (*DeleteBatchResponse, error)
 }
 
 type crudServiceClient struct {
@@ -53,6 +67,33 @@ func (c *crudServiceClient) Create(ctx context.Context, in *CreateRequest, opts
 	return out, nil
 }
 
+func (c *crudServiceClient) GetBarang(ctx context.Context, in *GetBarangRequest, opts ...grpc.CallOption) (*GetBarangResponse, error) {
+	out := new(GetBarangResponse)
+	err := c.cc.Invoke(ctx, "/crud.CrudService/GetBarang", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudServiceClient) UpdateBarang(ctx context.Context, in *UpdateBarangRequest, opts ...grpc.CallOption) (*UpdateBarangResponse, error) {
+	out := new(UpdateBarangResponse)
+	err := c.cc.Invoke(ctx, "/crud.CrudService/UpdateBarang", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudServiceClient) DeleteBarang(ctx context.Context, in *DeleteBarangRequest, opts ...grpc.CallOption) (*DeleteBarangResponse, error) {
+	out := new(DeleteBarangResponse)
+	err := c.cc.Invoke(ctx, "/crud.CrudService/DeleteBarang", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *crudServiceClient) ReadAll(ctx context.Context, in *ReadAllRequest, opts ...grpc.CallOption) (*ReadAllResponse, error) {
 	out := new(ReadAllResponse)
 	err := c.cc.Invoke(ctx, "/crud.CrudService/ReadAll", in, out, opts...)
@@ -116,6 +157,111 @@ func (c *crudServiceClient) ReadNotExpiredBarang(ctx context.Context, in *ReadNo
 	return out, nil
 }
 
+func (c *crudServiceClient) ReadExpiringBarang(ctx context.Context, in *ReadExpiringBarangRequest, opts ...grpc.CallOption) (*ReadExpiringBarangResponse, error) {
+	out := new(ReadExpiringBarangResponse)
+	err := c.cc.Invoke(ctx, "/crud.CrudService/ReadExpiringBarang", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudServiceClient) StreamAll(ctx context.Context, in *StreamAllRequest, opts ...grpc.CallOption) (CrudService_StreamAllClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CrudService_ServiceDesc.Streams[0], "/crud.CrudService/StreamAll", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &crudServiceStreamAllClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CrudService_StreamAllClient interface {
+	Recv() (*ResponseRead, error)
+	grpc.ClientStream
+}
+
+type crudServiceStreamAllClient struct {
+	grpc.ClientStream
+}
+
+func (x *crudServiceStreamAllClient) Recv() (*ResponseRead, error) {
+	m := new(ResponseRead)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *crudServiceClient) StreamExpiredBarang(ctx context.Context, in *StreamExpiredBarangRequest, opts ...grpc.CallOption) (CrudService_StreamExpiredBarangClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CrudService_ServiceDesc.Streams[1], "/crud.CrudService/StreamExpiredBarang", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &crudServiceStreamExpiredBarangClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CrudService_StreamExpiredBarangClient interface {
+	Recv() (*ResponseReadExpired, error)
+	grpc.ClientStream
+}
+
+type crudServiceStreamExpiredBarangClient struct {
+	grpc.ClientStream
+}
+
+func (x *crudServiceStreamExpiredBarangClient) Recv() (*ResponseReadExpired, error) {
+	m := new(ResponseReadExpired)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *crudServiceClient) StreamNotExpiredBarang(ctx context.Context, in *StreamNotExpiredBarangRequest, opts ...grpc.CallOption) (CrudService_StreamNotExpiredBarangClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CrudService_ServiceDesc.Streams[2], "/crud.CrudService/StreamNotExpiredBarang", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &crudServiceStreamNotExpiredBarangClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CrudService_StreamNotExpiredBarangClient interface {
+	Recv() (*ResponseReadNotExpired, error)
+	grpc.ClientStream
+}
+
+type crudServiceStreamNotExpiredBarangClient struct {
+	grpc.ClientStream
+}
+
+func (x *crudServiceStreamNotExpiredBarangClient) Recv() (*ResponseReadNotExpired, error) {
+	m := new(ResponseReadNotExpired)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *crudServiceClient) UpdateHargaBatch(ctx context.Context, in *UpdateHargaBatchRequest, opts ...grpc.CallOption) (*UpdateHargaBatchResponse, error) {
 	out := new(UpdateHargaBatchResponse)
 	err := c.cc.Invoke(ctx, "/crud.CrudService/UpdateHargaBatch", in, out, opts...)
@@ -134,11 +280,75 @@ func (c *crudServiceClient) CreateBulkRef(ctx context.Context, in *CreateBulkRef
 	return out, nil
 }
 
+func (c *crudServiceClient) ImportRef(ctx context.Context, opts ...grpc.CallOption) (CrudService_ImportRefClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CrudService_ServiceDesc.Streams[3], "/crud.CrudService/ImportRef", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &crudServiceImportRefClient{stream}
+	return x, nil
+}
+
+type CrudService_ImportRefClient interface {
+	Send(*CreateBulkRef) error
+	CloseAndRecv() (*ImportRefResponse, error)
+	grpc.ClientStream
+}
+
+type crudServiceImportRefClient struct {
+	grpc.ClientStream
+}
+
+func (x *crudServiceImportRefClient) Send(m *CreateBulkRef) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *crudServiceImportRefClient) CloseAndRecv() (*ImportRefResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportRefResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *crudServiceClient) GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchResponse, error) {
+	out := new(GetBatchResponse)
+	err := c.cc.Invoke(ctx, "/crud.CrudService/GetBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudServiceClient) UpdateBatch(ctx context.Context, in *UpdateBatchRequest, opts ...grpc.CallOption) (*UpdateBatchResponse, error) {
+	out := new(UpdateBatchResponse)
+	err := c.cc.Invoke(ctx, "/crud.CrudService/UpdateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudServiceClient) DeleteBatch(ctx context.Context, in *DeleteBatchRequest, opts ...grpc.CallOption) (*DeleteBatchResponse, error) {
+	out := new(DeleteBatchResponse)
+	err := c.cc.Invoke(ctx, "/crud.CrudService/DeleteBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CrudServiceServer is the server API for CrudService service.
 // All implementations must embed UnimplementedCrudServiceServer
 // for forward compatibility
 type CrudServiceServer interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	GetBarang(context.Context, *GetBarangRequest) (*GetBarangResponse, error)
+	UpdateBarang(context.Context, *UpdateBarangRequest) (*UpdateBarangResponse, error)
+	DeleteBarang(context.Context, *DeleteBarangRequest) (*DeleteBarangResponse, error)
 	ReadAll(context.Context, *ReadAllRequest) (*ReadAllResponse, error)
 	ReadWithCategory(context.Context, *ReadWithCategoryRequest) (*ReadWithCategoryResponse, error)
 	ReadWithJenis(context.Context, *ReadWithJenisRequest) (*ReadWithJenisResponse, error)
@@ -146,10 +356,21 @@ type CrudServiceServer interface {
 	ReadWithBatch(context.Context, *ReadWithBatchRequest) (*ReadWithBatchResponse, error)
 	ReadExpiredBarang(context.Context, *ReadExpiredBarangRequest) (*ReadExpiredBarangResponse, error)
 	ReadNotExpiredBarang(context.Context, *ReadNotExpiredBarangRequest) (*ReadNotExpiredBarangResponse, error)
+	ReadExpiringBarang(context.Context, *ReadExpiringBarangRequest) (*ReadExpiringBarangResponse, error)
+	// streaming variants for exports, one row per message
+	StreamAll(*StreamAllRequest, CrudService_StreamAllServer) error
+	StreamExpiredBarang(*StreamExpiredBarangRequest, CrudService_StreamExpiredBarangServer) error
+	StreamNotExpiredBarang(*StreamNotExpiredBarangRequest, CrudService_StreamNotExpiredBarangServer) error
 	// logika update
 	UpdateHargaBatch(context.Context, *UpdateHargaBatchRequest) (*UpdateHargaBatchResponse, error)
 	// logika create bulk
 	CreateBulkRef(context.Context, *CreateBulkRefRequest) (*CreateBulkRefResponse, error)
+	// streaming import of ref_barang, committed in chunks
+	ImportRef(CrudService_ImportRefServer) error
+	// per-batch maintenance of ref_barang
+	GetBatch(context.Context, *GetBatchRequest) (*GetBatchResponse, error)
+	UpdateBatch(context.Context, *UpdateBatchRequest) (*UpdateBatchResponse, error)
+	DeleteBatch(context.Context, *DeleteBatchRequest) (*DeleteBatchResponse, error)
 	mustEmbedUnimplementedCrudServiceServer()
 }
 
@@ -160,6 +381,15 @@ type UnimplementedCrudServiceServer struct {
 func (UnimplementedCrudServiceServer) Create(context.Context, *CreateRequest) (*CreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedCrudServiceServer) GetBarang(context.Context, *GetBarangRequest) (*GetBarangResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBarang not implemented")
+}
+func (UnimplementedCrudServiceServer) UpdateBarang(context.Context, *UpdateBarangRequest) (*UpdateBarangResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBarang not implemented")
+}
+func (UnimplementedCrudServiceServer) DeleteBarang(context.Context, *DeleteBarangRequest) (*DeleteBarangResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBarang not implemented")
+}
 func (UnimplementedCrudServiceServer) ReadAll(context.Context, *ReadAllRequest) (*ReadAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadAll not implemented")
 }
@@ -181,12 +411,36 @@ func (UnimplementedCrudServiceServer) ReadExpiredBarang(context.Context, *ReadEx
 func (UnimplementedCrudServiceServer) ReadNotExpiredBarang(context.Context, *ReadNotExpiredBarangRequest) (*ReadNotExpiredBarangResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadNotExpiredBarang not implemented")
 }
+func (UnimplementedCrudServiceServer) ReadExpiringBarang(context.Context, *ReadExpiringBarangRequest) (*ReadExpiringBarangResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadExpiringBarang not implemented")
+}
+func (UnimplementedCrudServiceServer) StreamAll(*StreamAllRequest, CrudService_StreamAllServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAll not implemented")
+}
+func (UnimplementedCrudServiceServer) StreamExpiredBarang(*StreamExpiredBarangRequest, CrudService_StreamExpiredBarangServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExpiredBarang not implemented")
+}
+func (UnimplementedCrudServiceServer) StreamNotExpiredBarang(*StreamNotExpiredBarangRequest, CrudService_StreamNotExpiredBarangServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNotExpiredBarang not implemented")
+}
 func (UnimplementedCrudServiceServer) UpdateHargaBatch(context.Context, *UpdateHargaBatchRequest) (*UpdateHargaBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateHargaBatch not implemented")
 }
 func (UnimplementedCrudServiceServer) CreateBulkRef(context.Context, *CreateBulkRefRequest) (*CreateBulkRefResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBulkRef not implemented")
 }
+func (UnimplementedCrudServiceServer) ImportRef(CrudService_ImportRefServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportRef not implemented")
+}
+func (UnimplementedCrudServiceServer) GetBatch(context.Context, *GetBatchRequest) (*GetBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatch not implemented")
+}
+func (UnimplementedCrudServiceServer) UpdateBatch(context.Context, *UpdateBatchRequest) (*UpdateBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatch not implemented")
+}
+func (UnimplementedCrudServiceServer) DeleteBatch(context.Context, *DeleteBatchRequest) (*DeleteBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBatch not implemented")
+}
 func (UnimplementedCrudServiceServer) mustEmbedUnimplementedCrudServiceServer() {}
 
 // UnsafeCrudServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -218,6 +472,60 @@ func _CrudService_Create_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CrudService_GetBarang_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBarangRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudServiceServer).GetBarang(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.CrudService/GetBarang",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudServiceServer).GetBarang(ctx, req.(*GetBarangRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudService_UpdateBarang_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBarangRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudServiceServer).UpdateBarang(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.CrudService/UpdateBarang",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudServiceServer).UpdateBarang(ctx, req.(*UpdateBarangRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudService_DeleteBarang_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBarangRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudServiceServer).DeleteBarang(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.CrudService/DeleteBarang",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudServiceServer).DeleteBarang(ctx, req.(*DeleteBarangRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CrudService_ReadAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadAllRequest)
 	if err := dec(in); err != nil {
@@ -344,6 +652,87 @@ func _CrudService_ReadNotExpiredBarang_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CrudService_ReadExpiringBarang_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadExpiringBarangRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudServiceServer).ReadExpiringBarang(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.CrudService/ReadExpiringBarang",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudServiceServer).ReadExpiringBarang(ctx, req.(*ReadExpiringBarangRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudService_StreamAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAllRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CrudServiceServer).StreamAll(m, &crudServiceStreamAllServer{stream})
+}
+
+type CrudService_StreamAllServer interface {
+	Send(*ResponseRead) error
+	grpc.ServerStream
+}
+
+type crudServiceStreamAllServer struct {
+	grpc.ServerStream
+}
+
+func (x *crudServiceStreamAllServer) Send(m *ResponseRead) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CrudService_StreamExpiredBarang_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamExpiredBarangRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CrudServiceServer).StreamExpiredBarang(m, &crudServiceStreamExpiredBarangServer{stream})
+}
+
+type CrudService_StreamExpiredBarangServer interface {
+	Send(*ResponseReadExpired) error
+	grpc.ServerStream
+}
+
+type crudServiceStreamExpiredBarangServer struct {
+	grpc.ServerStream
+}
+
+func (x *crudServiceStreamExpiredBarangServer) Send(m *ResponseReadExpired) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CrudService_StreamNotExpiredBarang_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNotExpiredBarangRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CrudServiceServer).StreamNotExpiredBarang(m, &crudServiceStreamNotExpiredBarangServer{stream})
+}
+
+type CrudService_StreamNotExpiredBarangServer interface {
+	Send(*ResponseReadNotExpired) error
+	grpc.ServerStream
+}
+
+type crudServiceStreamNotExpiredBarangServer struct {
+	grpc.ServerStream
+}
+
+func (x *crudServiceStreamNotExpiredBarangServer) Send(m *ResponseReadNotExpired) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CrudService_UpdateHargaBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateHargaBatchRequest)
 	if err := dec(in); err != nil {
@@ -380,6 +769,86 @@ func _CrudService_CreateBulkRef_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CrudService_ImportRef_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CrudServiceServer).ImportRef(&crudServiceImportRefServer{stream})
+}
+
+type CrudService_ImportRefServer interface {
+	SendAndClose(*ImportRefResponse) error
+	Recv() (*CreateBulkRef, error)
+	grpc.ServerStream
+}
+
+type crudServiceImportRefServer struct {
+	grpc.ServerStream
+}
+
+func (x *crudServiceImportRefServer) SendAndClose(m *ImportRefResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *crudServiceImportRefServer) Recv() (*CreateBulkRef, error) {
+	m := new(CreateBulkRef)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CrudService_GetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudServiceServer).GetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.CrudService/GetBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudServiceServer).GetBatch(ctx, req.(*GetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudService_UpdateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudServiceServer).UpdateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.CrudService/UpdateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudServiceServer).UpdateBatch(ctx, req.(*UpdateBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudService_DeleteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudServiceServer).DeleteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.CrudService/DeleteBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudServiceServer).DeleteBatch(ctx, req.(*DeleteBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CrudService_ServiceDesc is the grpc.ServiceDesc for CrudService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -392,15 +861,27 @@ var CrudService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CrudService_Create_Handler,
 		},
 		{
-			MethodName: "ReadAll",
-			Handler:    _CrudService_ReadAll_Handler,
+			MethodName: "GetBarang",
+			Handler:    _CrudService_GetBarang_Handler,
 		},
 		{
-			MethodName: "ReadWithCategory",
-			Handler:    _CrudService_ReadWithCategory_Handler,
+			MethodName: "UpdateBarang",
+			Handler:    _CrudService_UpdateBarang_Handler,
 		},
 		{
-			MethodName: "ReadWithJenis",
+			MethodName: "DeleteBarang",
+			Handler:    _CrudService_DeleteBarang_Handler,
+		},
+		{
+			MethodName: "ReadAll",
+			Handler:    _CrudService_ReadAll_Handler,
+		},
+		{
+			MethodName: "ReadWithCategory",
+			Handler:    _CrudService_ReadWithCategory_Handler,
+		},
+		{
+			MethodName: "ReadWithJenis",
 			Handler:    _CrudService_ReadWithJenis_Handler,
 		},
 		{
@@ -419,6 +900,10 @@ var CrudService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadNotExpiredBarang",
 			Handler:    _CrudService_ReadNotExpiredBarang_Handler,
 		},
+		{
+			MethodName: "ReadExpiringBarang",
+			Handler:    _CrudService_ReadExpiringBarang_Handler,
+		},
 		{
 			MethodName: "UpdateHargaBatch",
 			Handler:    _CrudService_UpdateHargaBatch_Handler,
@@ -427,6 +912,521 @@ var CrudService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBulkRef",
 			Handler:    _CrudService_CreateBulkRef_Handler,
 		},
+		{
+			MethodName: "GetBatch",
+			Handler:    _CrudService_GetBatch_Handler,
+		},
+		{
+			MethodName: "UpdateBatch",
+			Handler:    _CrudService_UpdateBatch_Handler,
+		},
+		{
+			MethodName: "DeleteBatch",
+			Handler:    _CrudService_DeleteBatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAll",
+			Handler:       _CrudService_StreamAll_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamExpiredBarang",
+			Handler:       _CrudService_StreamExpiredBarang_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamNotExpiredBarang",
+			Handler:       _CrudService_StreamNotExpiredBarang_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportRef",
+			Handler:       _CrudService_ImportRef_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/service.proto",
+}
+
+// ReferensiServiceClient is the client API for ReferensiService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReferensiServiceClient interface {
+	ListKategori(ctx context.Context, in *ListKategoriRequest, opts ...grpc.CallOption) (*ListKategoriResponse, error)
+	CreateKategori(ctx context.Context, in *CreateKategoriRequest, opts ...grpc.CallOption) (*CreateKategoriResponse, error)
+	UpdateKategori(ctx context.Context, in *UpdateKategoriRequest, opts ...grpc.CallOption) (*UpdateKategoriResponse, error)
+	DeleteKategori(ctx context.Context, in *DeleteKategoriRequest, opts ...grpc.CallOption) (*DeleteKategoriResponse, error)
+	ListJenis(ctx context.Context, in *ListJenisRequest, opts ...grpc.CallOption) (*ListJenisResponse, error)
+	CreateJenis(ctx context.Context, in *CreateJenisRequest, opts ...grpc.CallOption) (*CreateJenisResponse, error)
+	UpdateJenis(ctx context.Context, in *UpdateJenisRequest, opts ...grpc.CallOption) (*UpdateJenisResponse, error)
+	DeleteJenis(ctx context.Context, in *DeleteJenisRequest, opts ...grpc.CallOption) (*DeleteJenisResponse, error)
+	ListMaterial(ctx context.Context, in *ListMaterialRequest, opts ...grpc.CallOption) (*ListMaterialResponse, error)
+	CreateMaterial(ctx context.Context, in *CreateMaterialRequest, opts ...grpc.CallOption) (*CreateMaterialResponse, error)
+	UpdateMaterial(ctx context.Context, in *UpdateMaterialRequest, opts ...grpc.CallOption) (*UpdateMaterialResponse, error)
+	DeleteMaterial(ctx context.Context, in *DeleteMaterialRequest, opts ...grpc.CallOption) (*DeleteMaterialResponse, error)
+}
+
+type referensiServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReferensiServiceClient(cc grpc.ClientConnInterface) ReferensiServiceClient {
+	return &referensiServiceClient{cc}
+}
+
+func (c *referensiServiceClient) ListKategori(ctx context.Context, in *ListKategoriRequest, opts ...grpc.CallOption) (*ListKategoriResponse, error) {
+	out := new(ListKategoriResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/ListKategori", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) CreateKategori(ctx context.Context, in *CreateKategoriRequest, opts ...grpc.CallOption) (*CreateKategoriResponse, error) {
+	out := new(CreateKategoriResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/CreateKategori", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) UpdateKategori(ctx context.Context, in *UpdateKategoriRequest, opts ...grpc.CallOption) (*UpdateKategoriResponse, error) {
+	out := new(UpdateKategoriResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/UpdateKategori", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) DeleteKategori(ctx context.Context, in *DeleteKategoriRequest, opts ...grpc.CallOption) (*DeleteKategoriResponse, error) {
+	out := new(DeleteKategoriResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/DeleteKategori", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) ListJenis(ctx context.Context, in *ListJenisRequest, opts ...grpc.CallOption) (*ListJenisResponse, error) {
+	out := new(ListJenisResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/ListJenis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) CreateJenis(ctx context.Context, in *CreateJenisRequest, opts ...grpc.CallOption) (*CreateJenisResponse, error) {
+	out := new(CreateJenisResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/CreateJenis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) UpdateJenis(ctx context.Context, in *UpdateJenisRequest, opts ...grpc.CallOption) (*UpdateJenisResponse, error) {
+	out := new(UpdateJenisResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/UpdateJenis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) DeleteJenis(ctx context.Context, in *DeleteJenisRequest, opts ...grpc.CallOption) (*DeleteJenisResponse, error) {
+	out := new(DeleteJenisResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/DeleteJenis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) ListMaterial(ctx context.Context, in *ListMaterialRequest, opts ...grpc.CallOption) (*ListMaterialResponse, error) {
+	out := new(ListMaterialResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/ListMaterial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) CreateMaterial(ctx context.Context, in *CreateMaterialRequest, opts ...grpc.CallOption) (*CreateMaterialResponse, error) {
+	out := new(CreateMaterialResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/CreateMaterial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) UpdateMaterial(ctx context.Context, in *UpdateMaterialRequest, opts ...grpc.CallOption) (*UpdateMaterialResponse, error) {
+	out := new(UpdateMaterialResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/UpdateMaterial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referensiServiceClient) DeleteMaterial(ctx context.Context, in *DeleteMaterialRequest, opts ...grpc.CallOption) (*DeleteMaterialResponse, error) {
+	out := new(DeleteMaterialResponse)
+	err := c.cc.Invoke(ctx, "/crud.ReferensiService/DeleteMaterial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReferensiServiceServer is the server API for ReferensiService service.
+// All implementations must embed UnimplementedReferensiServiceServer
+// for forward compatibility
+type ReferensiServiceServer interface {
+	ListKategori(context.Context, *ListKategoriRequest) (*ListKategoriResponse, error)
+	CreateKategori(context.Context, *CreateKategoriRequest) (*CreateKategoriResponse, error)
+	UpdateKategori(context.Context, *UpdateKategoriRequest) (*UpdateKategoriResponse, error)
+	DeleteKategori(context.Context, *DeleteKategoriRequest) (*DeleteKategoriResponse, error)
+	ListJenis(context.Context, *ListJenisRequest) (*ListJenisResponse, error)
+	CreateJenis(context.Context, *CreateJenisRequest) (*CreateJenisResponse, error)
+	UpdateJenis(context.Context, *UpdateJenisRequest) (*UpdateJenisResponse, error)
+	DeleteJenis(context.Context, *DeleteJenisRequest) (*DeleteJenisResponse, error)
+	ListMaterial(context.Context, *ListMaterialRequest) (*ListMaterialResponse, error)
+	CreateMaterial(context.Context, *CreateMaterialRequest) (*CreateMaterialResponse, error)
+	UpdateMaterial(context.Context, *UpdateMaterialRequest) (*UpdateMaterialResponse, error)
+	DeleteMaterial(context.Context, *DeleteMaterialRequest) (*DeleteMaterialResponse, error)
+	mustEmbedUnimplementedReferensiServiceServer()
+}
+
+// UnimplementedReferensiServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReferensiServiceServer struct {
+}
+
+func (UnimplementedReferensiServiceServer) ListKategori(context.Context, *ListKategoriRequest) (*ListKategoriResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKategori not implemented")
+}
+func (UnimplementedReferensiServiceServer) CreateKategori(context.Context, *CreateKategoriRequest) (*CreateKategoriResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKategori not implemented")
+}
+func (UnimplementedReferensiServiceServer) UpdateKategori(context.Context, *UpdateKategoriRequest) (*UpdateKategoriResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKategori not implemented")
+}
+func (UnimplementedReferensiServiceServer) DeleteKategori(context.Context, *DeleteKategoriRequest) (*DeleteKategoriResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKategori not implemented")
+}
+func (UnimplementedReferensiServiceServer) ListJenis(context.Context, *ListJenisRequest) (*ListJenisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJenis not implemented")
+}
+func (UnimplementedReferensiServiceServer) CreateJenis(context.Context, *CreateJenisRequest) (*CreateJenisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJenis not implemented")
+}
+func (UnimplementedReferensiServiceServer) UpdateJenis(context.Context, *UpdateJenisRequest) (*UpdateJenisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJenis not implemented")
+}
+func (UnimplementedReferensiServiceServer) DeleteJenis(context.Context, *DeleteJenisRequest) (*DeleteJenisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJenis not implemented")
+}
+func (UnimplementedReferensiServiceServer) ListMaterial(context.Context, *ListMaterialRequest) (*ListMaterialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaterial not implemented")
+}
+func (UnimplementedReferensiServiceServer) CreateMaterial(context.Context, *CreateMaterialRequest) (*CreateMaterialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMaterial not implemented")
+}
+func (UnimplementedReferensiServiceServer) UpdateMaterial(context.Context, *UpdateMaterialRequest) (*UpdateMaterialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaterial not implemented")
+}
+func (UnimplementedReferensiServiceServer) DeleteMaterial(context.Context, *DeleteMaterialRequest) (*DeleteMaterialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaterial not implemented")
+}
+func (UnimplementedReferensiServiceServer) mustEmbedUnimplementedReferensiServiceServer() {}
+
+// UnsafeReferensiServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReferensiServiceServer will
+// result in compilation errors.
+type UnsafeReferensiServiceServer interface {
+	mustEmbedUnimplementedReferensiServiceServer()
+}
+
+func RegisterReferensiServiceServer(s grpc.ServiceRegistrar, srv ReferensiServiceServer) {
+	s.RegisterService(&ReferensiService_ServiceDesc, srv)
+}
+
+func _ReferensiService_ListKategori_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKategoriRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).ListKategori(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/ListKategori",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).ListKategori(ctx, req.(*ListKategoriRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_CreateKategori_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKategoriRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).CreateKategori(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/CreateKategori",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).CreateKategori(ctx, req.(*CreateKategoriRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_UpdateKategori_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKategoriRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).UpdateKategori(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/UpdateKategori",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).UpdateKategori(ctx, req.(*UpdateKategoriRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_DeleteKategori_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKategoriRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).DeleteKategori(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/DeleteKategori",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).DeleteKategori(ctx, req.(*DeleteKategoriRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_ListJenis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJenisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).ListJenis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/ListJenis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).ListJenis(ctx, req.(*ListJenisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_CreateJenis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJenisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).CreateJenis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/CreateJenis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).CreateJenis(ctx, req.(*CreateJenisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_UpdateJenis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJenisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).UpdateJenis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/UpdateJenis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).UpdateJenis(ctx, req.(*UpdateJenisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_DeleteJenis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJenisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).DeleteJenis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/DeleteJenis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).DeleteJenis(ctx, req.(*DeleteJenisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_ListMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).ListMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/ListMaterial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).ListMaterial(ctx, req.(*ListMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_CreateMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).CreateMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/CreateMaterial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).CreateMaterial(ctx, req.(*CreateMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_UpdateMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).UpdateMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/UpdateMaterial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).UpdateMaterial(ctx, req.(*UpdateMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferensiService_DeleteMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferensiServiceServer).DeleteMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crud.ReferensiService/DeleteMaterial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferensiServiceServer).DeleteMaterial(ctx, req.(*DeleteMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReferensiService_ServiceDesc is the grpc.ServiceDesc for ReferensiService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReferensiService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crud.ReferensiService",
+	HandlerType: (*ReferensiServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListKategori",
+			Handler:    _ReferensiService_ListKategori_Handler,
+		},
+		{
+			MethodName: "CreateKategori",
+			Handler:    _ReferensiService_CreateKategori_Handler,
+		},
+		{
+			MethodName: "UpdateKategori",
+			Handler:    _ReferensiService_UpdateKategori_Handler,
+		},
+		{
+			MethodName: "DeleteKategori",
+			Handler:    _ReferensiService_DeleteKategori_Handler,
+		},
+		{
+			MethodName: "ListJenis",
+			Handler:    _ReferensiService_ListJenis_Handler,
+		},
+		{
+			MethodName: "CreateJenis",
+			Handler:    _ReferensiService_CreateJenis_Handler,
+		},
+		{
+			MethodName: "UpdateJenis",
+			Handler:    _ReferensiService_UpdateJenis_Handler,
+		},
+		{
+			MethodName: "DeleteJenis",
+			Handler:    _ReferensiService_DeleteJenis_Handler,
+		},
+		{
+			MethodName: "ListMaterial",
+			Handler:    _ReferensiService_ListMaterial_Handler,
+		},
+		{
+			MethodName: "CreateMaterial",
+			Handler:    _ReferensiService_CreateMaterial_Handler,
+		},
+		{
+			MethodName: "UpdateMaterial",
+			Handler:    _ReferensiService_UpdateMaterial_Handler,
+		},
+		{
+			MethodName: "DeleteMaterial",
+			Handler:    _ReferensiService_DeleteMaterial_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/service.proto",
@@ -0,0 +1,141 @@
+// server/barang.go
+package main
+
+import (
+	"context"
+	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
+	"log"
+	"time"
+)
+
+func barangToProto(barang storage.Barang) *crud.Barang {
+	return &crud.Barang{
+		IdBarang:     barang.IDBarang,
+		NamaBarang:   barang.NamaBarang,
+		FotoBarang:   barang.FotoBarang,
+		Harga:        barang.Harga,
+		IdKategori:   barang.IDKategori,
+		NamaKategori: barang.NamaKategori,
+		IdJenis:      barang.IDJenis,
+		NamaJenis:    barang.NamaJenis,
+		IdMaterial:   barang.IDMaterial,
+		NamaMaterial: barang.NamaMaterial,
+	}
+}
+
+func (s *server) Create(ctx context.Context, req *crud.CreateRequest) (*crud.CreateResponse, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	idBarang, err := s.barang.CreateBarang(ctx, storage.NewBarang{
+		NamaBarang: req.NamaBarang,
+		FotoBarang: req.FotoBarang,
+		Harga:      req.Harga,
+		IDKategori: req.IdKategori,
+		IDJenis:    req.IdJenis,
+		IDMaterial: req.IdMaterial,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logDuration(startTime, cpuStart)
+	return &crud.CreateResponse{
+		Success:  true,
+		Message:  "Barang created successfully",
+		IdBarang: idBarang,
+	}, nil
+}
+
+func (s *server) GetBarang(ctx context.Context, req *crud.GetBarangRequest) (*crud.GetBarangResponse, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	result, err := s.barang.GetBarang(ctx, req.IdBarang)
+	if err != nil {
+		return nil, err
+	}
+	barang := barangToProto(result)
+
+	log.Printf("Total ukuran memori respons: %d bytes", calculateMemorySize(barang))
+	logDuration(startTime, cpuStart)
+	return &crud.GetBarangResponse{Barang: barang}, nil
+}
+
+// populatedBarangFields is the implied update mask when the client sends none.
+func populatedBarangFields(req *crud.UpdateBarangRequest) []string {
+	var paths []string
+	if req.NamaBarang != "" {
+		paths = append(paths, "nama_barang")
+	}
+	if req.FotoBarang != "" {
+		paths = append(paths, "foto_barang")
+	}
+	if req.Harga != 0 {
+		paths = append(paths, "harga")
+	}
+	if req.IdKategori != 0 {
+		paths = append(paths, "id_kategori")
+	}
+	if req.IdJenis != 0 {
+		paths = append(paths, "id_jenis")
+	}
+	if req.IdMaterial != 0 {
+		paths = append(paths, "id_material")
+	}
+	return paths
+}
+
+func (s *server) UpdateBarang(ctx context.Context, req *crud.UpdateBarangRequest) (*crud.UpdateBarangResponse, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = populatedBarangFields(req)
+	}
+	if len(paths) == 0 {
+		return nil, invalidArgument("update_mask", "nothing to update")
+	}
+
+	var update storage.BarangUpdate
+	for _, path := range paths {
+		switch path {
+		case "nama_barang":
+			update.NamaBarang = &req.NamaBarang
+		case "foto_barang":
+			update.FotoBarang = &req.FotoBarang
+		case "harga":
+			update.Harga = &req.Harga
+		case "id_kategori":
+			update.IDKategori = &req.IdKategori
+		case "id_jenis":
+			update.IDJenis = &req.IdJenis
+		case "id_material":
+			update.IDMaterial = &req.IdMaterial
+		default:
+			return nil, invalidArgument("update_mask", "unknown update_mask path %q", path)
+		}
+	}
+
+	barang, err := s.barang.UpdateBarang(ctx, req.IdBarang, update)
+	if err != nil {
+		return nil, err
+	}
+
+	logDuration(startTime, cpuStart)
+	return &crud.UpdateBarangResponse{Success: true, Message: "Barang updated successfully", Barang: barangToProto(barang)}, nil
+}
+
+func (s *server) DeleteBarang(ctx context.Context, req *crud.DeleteBarangRequest) (*crud.DeleteBarangResponse, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	if err := s.barang.DeleteBarang(ctx, req.IdBarang); err != nil {
+		return nil, err
+	}
+
+	logDuration(startTime, cpuStart)
+	return &crud.DeleteBarangResponse{Success: true, Message: "Barang deleted successfully"}, nil
+}
@@ -0,0 +1,120 @@
+// server/batch.go
+package main
+
+import (
+	"context"
+	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
+	"time"
+)
+
+// dateLayout is the format of ref_barang.expired as exchanged with clients.
+const dateLayout = "2006-01-02"
+
+// batchKey is implemented by every request that addresses a single lot
+// either by id_ref_barang or by no_batch.
+type batchKey interface {
+	GetIdRefBarang() int64
+	GetNoBatch() string
+}
+
+func storageBatchKey(key batchKey) (storage.BatchKey, error) {
+	if key.GetNoBatch() == "" && key.GetIdRefBarang() == 0 {
+		return storage.BatchKey{}, invalidArgument("id_ref_barang", "id_ref_barang or no_batch is required")
+	}
+	return storage.BatchKey{IDRefBarang: key.GetIdRefBarang(), NoBatch: key.GetNoBatch()}, nil
+}
+
+func batchToProto(batch storage.Batch) *crud.Batch {
+	return &crud.Batch{
+		IdRefBarang: batch.IDRefBarang,
+		IdBarang:    batch.IDBarang,
+		NamaBarang:  batch.NamaBarang,
+		FotoBarang:  batch.FotoBarang,
+		Harga:       batch.Harga,
+		NomorBatch:  batch.NoBatch,
+		Stok:        batch.Stok,
+		TglExpired:  batch.Expired,
+	}
+}
+
+func (s *server) GetBatch(ctx context.Context, req *crud.GetBatchRequest) (*crud.GetBatchResponse, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	key, err := storageBatchKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.batch.GetBatch(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	logDuration(startTime, cpuStart)
+	return &crud.GetBatchResponse{Batch: batchToProto(batch)}, nil
+}
+
+func (s *server) UpdateBatch(ctx context.Context, req *crud.UpdateBatchRequest) (*crud.UpdateBatchResponse, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.Stok != 0 {
+			paths = append(paths, "stok")
+		}
+		if req.TglExpired != "" {
+			paths = append(paths, "tgl_expired")
+		}
+	}
+	if len(paths) == 0 {
+		return nil, invalidArgument("update_mask", "nothing to update")
+	}
+
+	var update storage.BatchUpdate
+	for _, path := range paths {
+		switch path {
+		case "stok":
+			update.Stok = &req.Stok
+		case "tgl_expired":
+			if _, err := time.Parse(dateLayout, req.TglExpired); err != nil {
+				return nil, invalidArgument("tgl_expired", "tgl_expired %q is not a YYYY-MM-DD date", req.TglExpired)
+			}
+			update.Expired = &req.TglExpired
+		default:
+			return nil, invalidArgument("update_mask", "unknown update_mask path %q", path)
+		}
+	}
+
+	key, err := storageBatchKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.batch.UpdateBatch(ctx, key, update)
+	if err != nil {
+		return nil, err
+	}
+
+	logDuration(startTime, cpuStart)
+	return &crud.UpdateBatchResponse{Success: true, Message: "Batch updated successfully", Batch: batchToProto(batch)}, nil
+}
+
+func (s *server) DeleteBatch(ctx context.Context, req *crud.DeleteBatchRequest) (*crud.DeleteBatchResponse, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	key, err := storageBatchKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.batch.DeleteBatch(ctx, key); err != nil {
+		return nil, err
+	}
+
+	logDuration(startTime, cpuStart)
+	return &crud.DeleteBatchResponse{Success: true, Message: "Batch deleted successfully"}, nil
+}
@@ -0,0 +1,361 @@
+// server/config.go
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
+)
+
+// config is the server configuration. Every setting comes from, in order of
+// increasing precedence, its default, the YAML file named by -config or
+// GRPC_CRUD_CONFIG, its GRPC_CRUD_* environment variable and its flag.
+type config struct {
+	Listen          string `yaml:"listen"`
+	Storage         string `yaml:"storage"`
+	Timezone        string `yaml:"timezone"`
+	ImportChunkSize int    `yaml:"import_chunk_size"`
+	LogLevel        string `yaml:"log_level"`
+	// RPCTimeout is the deadline of a unary call whose client set none, and
+	// StreamTimeout that of a streaming call; zero means no deadline.
+	RPCTimeout    time.Duration `yaml:"rpc_timeout"`
+	StreamTimeout time.Duration `yaml:"stream_timeout"`
+	// ShutdownTimeout bounds how long a SIGINT or SIGTERM waits for the
+	// running calls before cancelling them. ShutdownDelay is how long the
+	// health service reports NOT_SERVING before that, while new calls are
+	// still accepted, so that load balancers can stop routing first.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	ShutdownDelay   time.Duration `yaml:"shutdown_delay"`
+	// HealthInterval is how often the database is pinged for the health
+	// service.
+	HealthInterval time.Duration `yaml:"health_interval"`
+	// DebugListen is the address of the HTTP server exposing /debug/vars,
+	// including the database pool statistics; empty disables it.
+	DebugListen string   `yaml:"debug_listen"`
+	Database    dbConfig `yaml:"database"`
+}
+
+// dbConfig holds the database settings. DSN, when set, is used as is;
+// otherwise the DSN of the mysql or postgres backend is built from Host,
+// Port, User, Password, Name and the timeouts.
+type dbConfig struct {
+	DSN        string `yaml:"dsn"`
+	Host       string `yaml:"host"`
+	Port       int    `yaml:"port"`
+	User       string `yaml:"user"`
+	Password   string `yaml:"password"`
+	Name       string `yaml:"name"`
+	SQLitePath string `yaml:"sqlite_path"`
+
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+
+	// The database is pinged up to PingAttempts times at startup, waiting
+	// PingBackoff after the first failure and twice as long after each
+	// following one.
+	PingAttempts int           `yaml:"ping_attempts"`
+	PingBackoff  time.Duration `yaml:"ping_backoff"`
+	// StatsInterval is how often the pool statistics are logged; zero
+	// disables the log.
+	StatsInterval time.Duration `yaml:"stats_interval"`
+
+	ConnectTimeout time.Duration `yaml:"connect_timeout"`
+	// ReadTimeout and WriteTimeout are I/O timeouts of the MySQL driver;
+	// PostgreSQL has no equivalent DSN setting.
+	ReadTimeout  time.Duration `yaml:"read_timeout"`
+	WriteTimeout time.Duration `yaml:"write_timeout"`
+}
+
+func defaultConfig() config {
+	return config{
+		Listen:          ":50051",
+		Storage:         storageMySQL,
+		Timezone:        "Asia/Jakarta",
+		ImportChunkSize: 1000,
+		LogLevel:        "info",
+		RPCTimeout:      30 * time.Second,
+		ShutdownTimeout: 30 * time.Second,
+		HealthInterval:  5 * time.Second,
+		Database: dbConfig{
+			Host:            "localhost",
+			User:            "root",
+			Name:            "db_skripsi_2",
+			SQLitePath:      "grpc_crud.db",
+			MaxOpenConns:    25,
+			MaxIdleConns:    25,
+			ConnMaxLifetime: 30 * time.Minute,
+			PingAttempts:    5,
+			PingBackoff:     time.Second,
+			ConnectTimeout:  5 * time.Second,
+		},
+	}
+}
+
+// configEnvPrefix prefixes the environment variable of every setting.
+const configEnvPrefix = "GRPC_CRUD_"
+
+// setting ties a config field to its flag and environment variable.
+type setting struct {
+	name  string // flag name; the variable is configEnvPrefix + NAME
+	usage string
+	field func(*config) interface{} // pointer to the field
+}
+
+var settings = []setting{
+	{"listen", "address the gRPC server listens on", func(c *config) interface{} { return &c.Listen }},
+	{"storage", "storage backend: mysql, postgres, sqlite, or memory for a seeded in-memory store", func(c *config) interface{} { return &c.Storage }},
+	{"timezone", "business timezone used to decide which lots are expired", func(c *config) interface{} { return &c.Timezone }},
+	{"import-chunk-size", "rows per transaction in ImportRef", func(c *config) interface{} { return &c.ImportChunkSize }},
+	{"log-level", "level of the gRPC request log: debug, info, warn or error", func(c *config) interface{} { return &c.LogLevel }},
+	{"rpc-timeout", "deadline of a unary call that has none, 0 for none", func(c *config) interface{} { return &c.RPCTimeout }},
+	{"stream-timeout", "deadline of a streaming call that has none, 0 for none", func(c *config) interface{} { return &c.StreamTimeout }},
+	{"shutdown-timeout", "how long a shutdown waits for running calls before cancelling them", func(c *config) interface{} { return &c.ShutdownTimeout }},
+	{"shutdown-delay", "how long NOT_SERVING is reported before a shutdown stops accepting calls", func(c *config) interface{} { return &c.ShutdownDelay }},
+	{"health-interval", "how often the database is pinged for the health service", func(c *config) interface{} { return &c.HealthInterval }},
+	{"debug-listen", "address of the HTTP server exposing /debug/vars with the pool statistics, empty to disable", func(c *config) interface{} { return &c.DebugListen }},
+	{"dsn", "database DSN of the mysql or postgres backend, overriding the db-* settings; a postgres:// URL or libpq key=value DSN selects postgres", func(c *config) interface{} { return &c.Database.DSN }},
+	{"db-host", "database host", func(c *config) interface{} { return &c.Database.Host }},
+	{"db-port", "database port (default 3306 for mysql, 5432 for postgres)", func(c *config) interface{} { return &c.Database.Port }},
+	{"db-user", "database user", func(c *config) interface{} { return &c.Database.User }},
+	{"db-password", "database password; prefer the environment variable over the flag", func(c *config) interface{} { return &c.Database.Password }},
+	{"db-name", "database name", func(c *config) interface{} { return &c.Database.Name }},
+	{"sqlite-path", "database file of the sqlite storage backend", func(c *config) interface{} { return &c.Database.SQLitePath }},
+	{"db-max-open-conns", "maximum open database connections, 0 for no limit", func(c *config) interface{} { return &c.Database.MaxOpenConns }},
+	{"db-max-idle-conns", "maximum idle database connections", func(c *config) interface{} { return &c.Database.MaxIdleConns }},
+	{"db-conn-max-lifetime", "maximum lifetime of a database connection, 0 for no limit", func(c *config) interface{} { return &c.Database.ConnMaxLifetime }},
+	{"db-conn-max-idle-time", "maximum idle time of a database connection, 0 for no limit", func(c *config) interface{} { return &c.Database.ConnMaxIdleTime }},
+	{"db-ping-attempts", "times the database is pinged at startup before giving up", func(c *config) interface{} { return &c.Database.PingAttempts }},
+	{"db-ping-backoff", "wait after the first failed startup ping, doubled after each further failure", func(c *config) interface{} { return &c.Database.PingBackoff }},
+	{"db-stats-interval", "how often the pool statistics are logged, 0 to disable", func(c *config) interface{} { return &c.Database.StatsInterval }},
+	{"db-connect-timeout", "timeout of establishing a database connection", func(c *config) interface{} { return &c.Database.ConnectTimeout }},
+	{"db-read-timeout", "I/O read timeout of mysql connections, 0 for none", func(c *config) interface{} { return &c.Database.ReadTimeout }},
+	{"db-write-timeout", "I/O write timeout of mysql connections, 0 for none", func(c *config) interface{} { return &c.Database.WriteTimeout }},
+}
+
+// envName returns the environment variable of the setting, e.g.
+// GRPC_CRUD_DB_PASSWORD for db-password.
+func (s setting) envName() string {
+	name := []byte(s.name)
+	for i, c := range name {
+		switch {
+		case c == '-':
+			name[i] = '_'
+		case 'a' <= c && c <= 'z':
+			name[i] = c - 'a' + 'A'
+		}
+	}
+	return configEnvPrefix + string(name)
+}
+
+// loadConfig parses the command line, reads the config file and the
+// environment, and validates the result. The non-flag arguments stay
+// available through flag.Args.
+func loadConfig() (config, error) {
+	cfg := defaultConfig()
+
+	// The flags are parsed into their own copy of the config, and only
+	// those given on the command line are copied over at the end.
+	fromFlags := defaultConfig()
+	configPath := flag.String("config", os.Getenv(configEnvPrefix+"CONFIG"), "YAML config file")
+	for _, s := range settings {
+		switch p := s.field(&fromFlags).(type) {
+		case *string:
+			flag.StringVar(p, s.name, *p, s.usage)
+		case *int:
+			flag.IntVar(p, s.name, *p, s.usage)
+		case *time.Duration:
+			flag.DurationVar(p, s.name, *p, s.usage)
+		}
+	}
+	flag.Parse()
+
+	if *configPath != "" {
+		if err := readConfigFile(*configPath, &cfg); err != nil {
+			return config{}, err
+		}
+	}
+
+	for _, s := range settings {
+		value, ok := os.LookupEnv(s.envName())
+		if !ok {
+			continue
+		}
+		if err := setField(s.field(&cfg), value); err != nil {
+			return config{}, fmt.Errorf("%s: %w", s.envName(), err)
+		}
+	}
+
+	byName := map[string]setting{}
+	for _, s := range settings {
+		byName[s.name] = s
+	}
+	flag.Visit(func(f *flag.Flag) {
+		if s, ok := byName[f.Name]; ok {
+			copyField(s.field(&cfg), s.field(&fromFlags))
+		}
+	})
+
+	return cfg, cfg.validate()
+}
+
+// readConfigFile decodes the YAML file at path over cfg. Unknown keys are
+// rejected so that a misspelt setting does not silently keep its default.
+func readConfigFile(path string, cfg *config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	return nil
+}
+
+func setField(field interface{}, value string) error {
+	switch p := field.(type) {
+	case *string:
+		*p = value
+	case *int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		*p = n
+	case *time.Duration:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration such as 5s", value)
+		}
+		*p = d
+	}
+	return nil
+}
+
+func copyField(dst, src interface{}) {
+	switch p := dst.(type) {
+	case *string:
+		*p = *src.(*string)
+	case *int:
+		*p = *src.(*int)
+	case *time.Duration:
+		*p = *src.(*time.Duration)
+	}
+}
+
+// validate reports every invalid setting at once, each prefixed with its
+// flag name.
+func (c config) validate() error {
+	var errs []error
+	invalid := func(name, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%s: "+format, append([]interface{}{name}, args...)...))
+	}
+
+	if _, _, err := net.SplitHostPort(c.Listen); err != nil {
+		invalid("listen", "%q is not a host:port address", c.Listen)
+	}
+	if c.RPCTimeout < 0 {
+		invalid("rpc-timeout", "must not be negative, got %v", c.RPCTimeout)
+	}
+	if c.StreamTimeout < 0 {
+		invalid("stream-timeout", "must not be negative, got %v", c.StreamTimeout)
+	}
+	if c.ShutdownTimeout <= 0 {
+		invalid("shutdown-timeout", "must be positive, got %v", c.ShutdownTimeout)
+	}
+	if c.ShutdownDelay < 0 {
+		invalid("shutdown-delay", "must not be negative, got %v", c.ShutdownDelay)
+	}
+	if c.HealthInterval <= 0 {
+		invalid("health-interval", "must be positive, got %v", c.HealthInterval)
+	}
+	if c.DebugListen != "" {
+		if _, _, err := net.SplitHostPort(c.DebugListen); err != nil {
+			invalid("debug-listen", "%q is not a host:port address", c.DebugListen)
+		}
+	}
+	switch c.Storage {
+	case storageMySQL, storagePostgres, storageSQLite, storageMemory:
+	default:
+		invalid("storage", "unknown storage %q, want %s, %s, %s or %s", c.Storage, storageMySQL, storagePostgres, storageSQLite, storageMemory)
+	}
+	if _, err := time.LoadLocation(c.Timezone); err != nil {
+		invalid("timezone", "unknown timezone %q", c.Timezone)
+	}
+	if c.ImportChunkSize <= 0 {
+		invalid("import-chunk-size", "must be positive, got %d", c.ImportChunkSize)
+	}
+	if _, err := zapcore.ParseLevel(c.LogLevel); err != nil {
+		invalid("log-level", "unknown level %q, want debug, info, warn or error", c.LogLevel)
+	}
+
+	db := c.Database
+	if db.Port < 0 || db.Port > 65535 {
+		invalid("db-port", "must be between 1 and 65535, or 0 for the default, got %d", db.Port)
+	}
+	if db.DSN == "" && (c.Storage == storageMySQL || c.Storage == storagePostgres) {
+		if db.Host == "" {
+			invalid("db-host", "must be set when no dsn is given")
+		}
+		if db.User == "" {
+			invalid("db-user", "must be set when no dsn is given")
+		}
+		if db.Name == "" {
+			invalid("db-name", "must be set when no dsn is given")
+		}
+	}
+	if c.Storage == storageSQLite && db.SQLitePath == "" {
+		invalid("sqlite-path", "must be set for storage %s", storageSQLite)
+	}
+	if db.MaxOpenConns < 0 {
+		invalid("db-max-open-conns", "must not be negative, got %d", db.MaxOpenConns)
+	}
+	if db.MaxIdleConns < 0 {
+		invalid("db-max-idle-conns", "must not be negative, got %d", db.MaxIdleConns)
+	}
+	if db.MaxOpenConns > 0 && db.MaxIdleConns > db.MaxOpenConns {
+		invalid("db-max-idle-conns", "must not exceed db-max-open-conns (%d), got %d", db.MaxOpenConns, db.MaxIdleConns)
+	}
+	if db.ConnMaxLifetime < 0 {
+		invalid("db-conn-max-lifetime", "must not be negative, got %v", db.ConnMaxLifetime)
+	}
+	if db.ConnMaxIdleTime < 0 {
+		invalid("db-conn-max-idle-time", "must not be negative, got %v", db.ConnMaxIdleTime)
+	}
+	if db.PingAttempts < 1 {
+		invalid("db-ping-attempts", "must be at least 1, got %d", db.PingAttempts)
+	}
+	if db.PingBackoff < 0 {
+		invalid("db-ping-backoff", "must not be negative, got %v", db.PingBackoff)
+	}
+	if db.StatsInterval < 0 {
+		invalid("db-stats-interval", "must not be negative, got %v", db.StatsInterval)
+	}
+	if db.ConnectTimeout < 0 {
+		invalid("db-connect-timeout", "must not be negative, got %v", db.ConnectTimeout)
+	}
+	if db.ReadTimeout < 0 {
+		invalid("db-read-timeout", "must not be negative, got %v", db.ReadTimeout)
+	}
+	if db.WriteTimeout < 0 {
+		invalid("db-write-timeout", "must not be negative, got %v", db.WriteTimeout)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
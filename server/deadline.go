@@ -0,0 +1,43 @@
+// server/deadline.go
+package main
+
+import (
+	"context"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// deadlineUnaryInterceptor gives a call without a client deadline one of
+// timeout, so a slow query is cancelled in the database instead of running
+// on after the client gave up waiting. A client deadline is left as is.
+func deadlineUnaryInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
+
+// deadlineStreamInterceptor does the same for streams, except health Watch
+// streams: a load balancer keeps those open for as long as it routes here.
+func deadlineStreamInterceptor(timeout time.Duration) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.FullMethod == healthpb.Health_Watch_FullMethodName {
+			return handler(srv, ss)
+		}
+		if _, ok := ss.Context().Deadline(); ok || timeout <= 0 {
+			return handler(srv, ss)
+		}
+		ctx, cancel := context.WithTimeout(ss.Context(), timeout)
+		defer cancel()
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
@@ -0,0 +1,145 @@
+// server/errors.go
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"grpc_crud/storage"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the ErrorInfo domain of the errors this server generates.
+const errorDomain = "grpc_crud"
+
+// errorStatus turns an error into a gRPC status carrying a
+// google.rpc.ErrorInfo detail. Statuses are returned unchanged. Errors that
+// are neither a storage error nor a context error become Internal with a
+// generic message, so that driver text never reaches the client; the
+// original error is logged instead.
+func errorStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var dbErr *storage.DatabaseError
+	if errors.As(err, &dbErr) {
+		log.Printf("Database error: %v", dbErr.Cause)
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return errorWithInfo(codes.NotFound, "NOT_FOUND", err.Error())
+	case errors.Is(err, storage.ErrInvalidReference):
+		return invalidReference(err)
+	case errors.Is(err, storage.ErrReferenced):
+		return errorWithInfo(codes.FailedPrecondition, "STILL_REFERENCED", err.Error())
+	case errors.Is(err, storage.ErrAmbiguous):
+		return errorWithInfo(codes.FailedPrecondition, "AMBIGUOUS_NO_BATCH", err.Error())
+	case errors.Is(err, storage.ErrForeignKey):
+		return errorWithInfo(codes.FailedPrecondition, "FOREIGN_KEY_VIOLATION", err.Error())
+	case errors.Is(err, storage.ErrInvalidValue):
+		return errorWithInfo(codes.InvalidArgument, "INVALID_VALUE", err.Error())
+	case errors.Is(err, storage.ErrDuplicate):
+		return errorWithInfo(codes.AlreadyExists, "ALREADY_EXISTS", err.Error())
+	case errors.Is(err, storage.ErrUnavailable):
+		return errorWithInfo(codes.Unavailable, "DATABASE_UNAVAILABLE", err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorWithInfo(codes.DeadlineExceeded, "DEADLINE_EXCEEDED", "deadline exceeded")
+	case errors.Is(err, context.Canceled):
+		return errorWithInfo(codes.Canceled, "CANCELED", "request canceled")
+	}
+
+	log.Printf("Internal error: %v", err)
+	return errorWithInfo(codes.Internal, "INTERNAL", "internal error")
+}
+
+// rowErrorReason is the RowError.Reason of a row the database did not insert.
+// It goes through errorStatus like any other error, so a row failure that is
+// not a storage error is logged and reported without the driver text.
+func rowErrorReason(err error) string {
+	st := status.Convert(errorStatus(err))
+	if st.Code() == codes.Internal {
+		return "database rejected the row"
+	}
+	return st.Message()
+}
+
+func errorWithInfo(code codes.Code, reason, message string) error {
+	st := status.New(code, message)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// invalidReference returns the InvalidArgument status of a missing kategori,
+// jenis, material or barang. Besides the ErrorInfo it carries a
+// google.rpc.BadRequest detail naming the field when the error names it.
+func invalidReference(err error) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	info := &errdetails.ErrorInfo{Reason: "INVALID_REFERENCE", Domain: errorDomain}
+	var detailed *status.Status
+	var refErr *storage.ReferenceError
+	if errors.As(err, &refErr) {
+		detailed, err = st.WithDetails(info, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: refErr.Column, Description: st.Message()}},
+		})
+	} else {
+		detailed, err = st.WithDetails(info)
+	}
+	if err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// invalidArgument returns an InvalidArgument status with a
+// google.rpc.BadRequest detail naming the offending field.
+func invalidArgument(field, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+	st := status.New(codes.InvalidArgument, message)
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: message}},
+	}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// errorStatusUnaryInterceptor passes every error a handler returns through
+// errorStatus. Handlers return storage errors unchanged and leave the
+// conversion to it.
+func errorStatusUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, errorStatus(contextError(ctx, err))
+	}
+}
+
+func errorStatusStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return errorStatus(contextError(ss.Context(), handler(srv, ss)))
+	}
+}
+
+// contextError replaces an error that is not yet a status with the context
+// error once ctx is done. Drivers do not all report an aborted statement as
+// a context error; SQLite for one says "interrupted".
+func contextError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return ctx.Err()
+}
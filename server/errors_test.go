@@ -0,0 +1,82 @@
+// server/errors_test.go
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"grpc_crud/storage"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusDetails returns the ErrorInfo reason and the BadRequest fields of st.
+func statusDetails(st *status.Status) (reason string, fields []string) {
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			reason = detail.Reason
+		case *errdetails.BadRequest:
+			for _, violation := range detail.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	return reason, fields
+}
+
+func TestErrorStatus(t *testing.T) {
+	for _, test := range []struct {
+		err     error
+		code    codes.Code
+		reason  string
+		field   string
+		message string
+	}{
+		{fmt.Errorf("barang 7 %w", storage.ErrNotFound), codes.NotFound, "NOT_FOUND", "", "barang 7 not found"},
+		{&storage.ReferenceError{Column: "id_kategori", ID: 5}, codes.InvalidArgument, "INVALID_REFERENCE", "id_kategori", "id_kategori 5 does not exist"},
+		{fmt.Errorf("kategori, jenis or material %w", storage.ErrInvalidReference), codes.InvalidArgument, "INVALID_REFERENCE", "", "kategori, jenis or material does not exist"},
+		{fmt.Errorf("kategori 1 %w by barang", storage.ErrReferenced), codes.FailedPrecondition, "STILL_REFERENCED", "", "kategori 1 is still referenced by barang"},
+		{fmt.Errorf("no_batch %q %w", "B1", storage.ErrAmbiguous), codes.FailedPrecondition, "AMBIGUOUS_NO_BATCH", "", `no_batch "B1" matches more than one lot`},
+		{&storage.DatabaseError{Kind: storage.ErrDuplicate, Cause: errors.New("Duplicate entry 'x' for key 'uq'")}, codes.AlreadyExists, "ALREADY_EXISTS", "", "already exists"},
+		{&storage.DatabaseError{Kind: storage.ErrInvalidValue, Cause: errors.New("Data too long for column 'no_batch'")}, codes.InvalidArgument, "INVALID_VALUE", "", "has a value the database rejected"},
+		{&storage.DatabaseError{Kind: storage.ErrUnavailable, Cause: errors.New("Deadlock found")}, codes.Unavailable, "DATABASE_UNAVAILABLE", "", "database unavailable"},
+		{fmt.Errorf("query: %w", context.DeadlineExceeded), codes.DeadlineExceeded, "DEADLINE_EXCEEDED", "", "deadline exceeded"},
+		{context.Canceled, codes.Canceled, "CANCELED", "", "request canceled"},
+		{errors.New("Error 1064: You have an error in your SQL syntax"), codes.Internal, "INTERNAL", "", "internal error"},
+		{invalidArgument("page_size", "page_size must not be negative"), codes.InvalidArgument, "", "page_size", "page_size must not be negative"},
+	} {
+		st := status.Convert(errorStatus(test.err))
+		reason, fields := statusDetails(st)
+		var field string
+		if len(fields) > 0 {
+			field = fields[0]
+		}
+		if st.Code() != test.code || reason != test.reason || field != test.field || st.Message() != test.message {
+			t.Errorf("errorStatus(%v) = %v %q, reason %q, fields %v; want %v %q, reason %q, field %q",
+				test.err, st.Code(), st.Message(), reason, fields, test.code, test.message, test.reason, test.field)
+		}
+	}
+
+	if err := errorStatus(nil); err != nil {
+		t.Errorf("errorStatus(nil) = %v", err)
+	}
+}
+
+func TestRowErrorReason(t *testing.T) {
+	for _, test := range []struct {
+		err  error
+		want string
+	}{
+		{&storage.ReferenceError{Column: "id_barang", ID: 9}, "id_barang 9 does not exist"},
+		{&storage.DatabaseError{Kind: storage.ErrDuplicate, Cause: errors.New("Duplicate entry")}, "already exists"},
+		{errors.New("pq: syntax error at or near"), "database rejected the row"},
+	} {
+		if got := rowErrorReason(test.err); got != test.want {
+			t.Errorf("rowErrorReason(%v) = %q, want %q", test.err, got, test.want)
+		}
+	}
+}
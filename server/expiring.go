@@ -0,0 +1,107 @@
+// server/expiring.go
+package main
+
+import (
+	"context"
+	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
+	"log"
+	"time"
+)
+
+// businessDate returns the calendar date the request is about, as midnight
+// UTC: asOf when given, otherwise today in the business timezone.
+func (s *server) businessDate(asOf string) (time.Time, error) {
+	if asOf != "" {
+		date, err := time.Parse(dateLayout, asOf)
+		if err != nil {
+			return time.Time{}, invalidArgument("as_of", "as_of %q is not a YYYY-MM-DD date", asOf)
+		}
+		return date, nil
+	}
+	now := time.Now().In(s.location)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), nil
+}
+
+// expiringWindow resolves the requested window into inclusive start and end dates.
+func expiringWindow(req *crud.ReadExpiringBarangRequest, today time.Time) (time.Time, time.Time, error) {
+	switch window := req.Window.(type) {
+	case *crud.ReadExpiringBarangRequest_WithinDays:
+		return today, today.AddDate(0, 0, int(window.WithinDays)), nil
+	case *crud.ReadExpiringBarangRequest_DateRange:
+		start, err := time.Parse(dateLayout, window.DateRange.GetStartDate())
+		if err != nil {
+			return time.Time{}, time.Time{}, invalidArgument("date_range.start_date", "date_range.start_date %q is not a YYYY-MM-DD date", window.DateRange.GetStartDate())
+		}
+		end, err := time.Parse(dateLayout, window.DateRange.GetEndDate())
+		if err != nil {
+			return time.Time{}, time.Time{}, invalidArgument("date_range.end_date", "date_range.end_date %q is not a YYYY-MM-DD date", window.DateRange.GetEndDate())
+		}
+		if end.Before(start) {
+			return time.Time{}, time.Time{}, invalidArgument("date_range.end_date", "date_range.end_date must not be before date_range.start_date")
+		}
+		return start, end, nil
+	}
+	return time.Time{}, time.Time{}, invalidArgument("within_days", "within_days or date_range is required")
+}
+
+// ReadExpiringBarang lists lots by expiry date, soonest first, so staff can
+// plan markdowns before they expire.
+func (s *server) ReadExpiringBarang(ctx context.Context, req *crud.ReadExpiringBarangRequest) (*crud.ReadExpiringBarangResponse, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	page, pageSize, err := pageParams(req, "")
+	if err != nil {
+		return nil, err
+	}
+
+	today, err := s.businessDate(req.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := expiringWindow(req, today)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, totalSize, err := s.batch.ListExpiring(ctx, storage.ExpiringQuery{
+		Start:      start.Format(dateLayout),
+		End:        end.Format(dateLayout),
+		IDKategori: req.IdKategori,
+	}, page)
+	if err != nil {
+		return nil, err
+	}
+	rows, nextPageToken := nextPage(rows, pageSize, "", func(row storage.Batch) storage.Cursor {
+		return storage.Cursor{Value: row.Expired, ID: row.IDRefBarang}
+	})
+
+	var responses []*crud.ResponseReadExpiring
+
+	var totalMemorySize int
+
+	for _, row := range rows {
+		expired, err := time.Parse(dateLayout, row.Expired)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &crud.ResponseReadExpiring{
+			NamaBarang:    row.NamaBarang,
+			NomorBatch:    row.NoBatch,
+			Stok:          row.Stok,
+			TglExpired:    row.Expired,
+			NamaKategori:  row.NamaKategori,
+			DaysRemaining: int32(expired.Sub(today).Hours() / 24),
+		}
+
+		totalMemorySize += calculateMemorySize(response)
+		responses = append(responses, response)
+	}
+
+	log.Printf("Total ukuran memori respons: %d bytes", totalMemorySize)
+	logDuration(startTime, cpuStart)
+
+	return &crud.ReadExpiringBarangResponse{Responses: responses, NextPageToken: nextPageToken, TotalSize: totalSize}, nil
+}
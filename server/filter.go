@@ -0,0 +1,104 @@
+// server/filter.go
+package main
+
+import (
+	"encoding/json"
+	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// readAllFilter converts a ReadAllFilter into its storage form.
+func readAllFilter(filter *crud.ReadAllFilter) (storage.ItemFilter, error) {
+	if filter == nil {
+		return storage.ItemFilter{}, nil
+	}
+	if filter.HargaMin != nil && filter.HargaMax != nil && *filter.HargaMin > *filter.HargaMax {
+		return storage.ItemFilter{}, invalidArgument("filter.harga_min", "filter.harga_min must not be greater than filter.harga_max")
+	}
+	return storage.ItemFilter{
+		IDKategori:   filter.IdKategori,
+		IDJenis:      filter.IdJenis,
+		IDMaterial:   filter.IdMaterial,
+		HargaMin:     filter.HargaMin,
+		HargaMax:     filter.HargaMax,
+		NamaContains: filter.NamaContains,
+		BatchPrefix:  filter.BatchPrefix,
+	}, nil
+}
+
+// readAllSortFields are the order_by fields ReadAll accepts.
+var readAllSortFields = map[string]bool{
+	storage.SortByHarga:      true,
+	storage.SortByNamaBarang: true,
+	storage.SortByNoBatch:    true,
+}
+
+func parseReadAllOrderBy(orderBy string) (storage.ItemSort, error) {
+	words := strings.Fields(strings.ToLower(orderBy))
+	if len(words) == 0 {
+		return storage.ItemSort{}, nil
+	}
+
+	if !readAllSortFields[words[0]] || len(words) > 2 {
+		return storage.ItemSort{}, invalidArgument("order_by", "unsupported order_by %q", orderBy)
+	}
+	order := storage.ItemSort{Field: words[0]}
+	if len(words) == 2 {
+		switch words[1] {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return storage.ItemSort{}, invalidArgument("order_by", "unsupported order_by %q", orderBy)
+		}
+	}
+	return order, nil
+}
+
+// readAllQuery is the page token Query of a ReadAll listing: the sort and a
+// fingerprint of the filter, so that a token is only replayed against the
+// listing it was issued for.
+func readAllQuery(order storage.ItemSort, filter storage.ItemFilter) string {
+	query := order.Field
+	if order.Desc {
+		query += " desc"
+	}
+	if filter != (storage.ItemFilter{}) {
+		raw, _ := json.Marshal(filter)
+		h := fnv.New64a()
+		h.Write(raw)
+		query += ";" + strconv.FormatUint(h.Sum64(), 36)
+	}
+	return query
+}
+
+// checkReadAllCursor rejects a page token whose value does not fit the sort.
+// Tokens of other listings are already refused by their Query, so this only
+// catches a token the client edited.
+func checkReadAllCursor(order storage.ItemSort, after *storage.Cursor) error {
+	if after == nil || order.Field != storage.SortByHarga {
+		return nil
+	}
+	if _, err := strconv.Atoi(after.Value); err != nil {
+		return invalidArgument("page_token", "invalid page_token")
+	}
+	return nil
+}
+
+// readAllCursor returns the cursor builder for rows sorted by order.
+func readAllCursor(order storage.ItemSort) func(storage.Batch) storage.Cursor {
+	return func(row storage.Batch) storage.Cursor {
+		switch order.Field {
+		case storage.SortByHarga:
+			return storage.Cursor{Value: strconv.Itoa(int(row.Harga)), ID: row.IDRefBarang}
+		case storage.SortByNamaBarang:
+			return storage.Cursor{Value: row.NamaBarang, ID: row.IDRefBarang}
+		case storage.SortByNoBatch:
+			return storage.Cursor{Value: row.NoBatch, ID: row.IDRefBarang}
+		}
+		return storage.Cursor{ID: row.IDRefBarang}
+	}
+}
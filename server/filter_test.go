@@ -0,0 +1,64 @@
+// server/filter_test.go
+package main
+
+import (
+	"grpc_crud/storage"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseReadAllOrderBy(t *testing.T) {
+	for _, test := range []struct {
+		orderBy string
+		want    storage.ItemSort
+	}{
+		{"", storage.ItemSort{}},
+		{"harga", storage.ItemSort{Field: storage.SortByHarga}},
+		{"harga asc", storage.ItemSort{Field: storage.SortByHarga}},
+		{"  HARGA   DESC ", storage.ItemSort{Field: storage.SortByHarga, Desc: true}},
+		{"nama_barang desc", storage.ItemSort{Field: storage.SortByNamaBarang, Desc: true}},
+		{"no_batch", storage.ItemSort{Field: storage.SortByNoBatch}},
+	} {
+		if got, err := parseReadAllOrderBy(test.orderBy); err != nil || got != test.want {
+			t.Errorf("parseReadAllOrderBy(%q) = %+v, %v; want %+v", test.orderBy, got, err, test.want)
+		}
+	}
+
+	for _, orderBy := range []string{"stok", "harga up", "harga desc nama_barang", "id_ref_barang; DROP TABLE barang"} {
+		if _, err := parseReadAllOrderBy(orderBy); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("parseReadAllOrderBy(%q) = %v, want InvalidArgument", orderBy, err)
+		}
+	}
+}
+
+func TestReadAllQuery(t *testing.T) {
+	hargaMin := int32(1000)
+	queries := map[string]bool{}
+	for _, listing := range []struct {
+		order  storage.ItemSort
+		filter storage.ItemFilter
+	}{
+		{storage.ItemSort{}, storage.ItemFilter{}},
+		{storage.ItemSort{Field: storage.SortByHarga}, storage.ItemFilter{}},
+		{storage.ItemSort{Field: storage.SortByHarga, Desc: true}, storage.ItemFilter{}},
+		{storage.ItemSort{Field: storage.SortByHarga}, storage.ItemFilter{IDKategori: 1}},
+		{storage.ItemSort{Field: storage.SortByHarga}, storage.ItemFilter{HargaMin: &hargaMin}},
+		{storage.ItemSort{Field: storage.SortByHarga}, storage.ItemFilter{NamaContains: "teh"}},
+	} {
+		query := readAllQuery(listing.order, listing.filter)
+		if queries[query] {
+			t.Errorf("readAllQuery(%+v, %+v) = %q, the query of another listing", listing.order, listing.filter, query)
+		}
+		queries[query] = true
+	}
+
+	if readAllQuery(storage.ItemSort{}, storage.ItemFilter{}) != "" {
+		t.Error("readAllQuery of the default listing is not empty")
+	}
+	other := int32(1000)
+	if readAllQuery(storage.ItemSort{}, storage.ItemFilter{HargaMin: &hargaMin}) != readAllQuery(storage.ItemSort{}, storage.ItemFilter{HargaMin: &other}) {
+		t.Error("readAllQuery depends on the filter pointers rather than their values")
+	}
+}
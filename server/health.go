@@ -0,0 +1,95 @@
+// server/health.go
+package main
+
+import (
+	"context"
+	"grpc_crud/storage"
+	"log"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// healthService is grpc.health.v1.Health with a status for every service
+// of the server and for the server as a whole (the empty service name).
+type healthService struct {
+	*health.Server
+	services []string
+	// stopping is closed to end the open Watch streams at shutdown.
+	stopping chan struct{}
+}
+
+func newHealthService() *healthService {
+	return &healthService{Server: health.NewServer(), services: []string{""}, stopping: make(chan struct{})}
+}
+
+// register adds the health service to s. It must be called after the other
+// services are registered so that each of them gets a status. All start as
+// SERVING since the database answered the startup ping.
+func (hs *healthService) register(s *grpc.Server) {
+	for name := range s.GetServiceInfo() {
+		hs.services = append(hs.services, name)
+	}
+	healthpb.RegisterHealthServer(s, hs)
+	hs.setServingStatus(healthpb.HealthCheckResponse_SERVING)
+}
+
+func (hs *healthService) setServingStatus(st healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range hs.services {
+		hs.SetServingStatus(service, st)
+	}
+}
+
+// watch pings the store every interval and reports every service
+// NOT_SERVING while the ping fails. Once hs is shut down the reported
+// status no longer changes.
+func (hs *healthService) watch(store storage.Store, interval time.Duration) {
+	serving := true
+	for range time.Tick(interval) {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		err := store.Ping(ctx)
+		cancel()
+
+		if (err == nil) == serving {
+			continue
+		}
+		serving = err == nil
+		if serving {
+			log.Printf("Database reachable again, reporting SERVING")
+			hs.setServingStatus(healthpb.HealthCheckResponse_SERVING)
+		} else {
+			log.Printf("Database ping failed, reporting NOT_SERVING: %v", err)
+			hs.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+		}
+	}
+}
+
+// endWatches ends the open Watch streams, which would otherwise keep
+// GracefulStop waiting until the shutdown timeout.
+func (hs *healthService) endWatches() {
+	close(hs.stopping)
+}
+
+// watchStreamInterceptor lets endWatches cancel the Watch streams.
+func (hs *healthService) watchStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.FullMethod != healthpb.Health_Watch_FullMethodName {
+			return handler(srv, ss)
+		}
+		ctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+		go func() {
+			select {
+			case <-hs.stopping:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
@@ -0,0 +1,119 @@
+// server/import.go
+package main
+
+import (
+	"context"
+	"errors"
+	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
+	"io"
+	"log"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/status"
+)
+
+// maxImportErrors is the number of RowErrors an ImportRef summary lists at
+// most; Failed still counts every failed row.
+const maxImportErrors = 1000
+
+// refImport buffers the chunk currently being filled.
+type refImport struct {
+	batch   storage.BatchRepository
+	rows    []storage.NewBatch
+	pending []*crud.RowError // rows buffered but not yet committed
+	summary *crud.ImportRefResponse
+}
+
+func (imp *refImport) add(index int64, data *crud.CreateBulkRef) {
+	row, err := newBatchFromLegacy(data)
+	if err != nil {
+		imp.summary.Failed++
+		imp.addError(&crud.RowError{Index: index, NoBatch: data.NoBatch, Reason: err.Error()})
+		return
+	}
+	imp.rows = append(imp.rows, row)
+	imp.pending = append(imp.pending, &crud.RowError{Index: index, NoBatch: data.NoBatch})
+}
+
+// addError lists a failed row. Conversion errors are listed as soon as the
+// row arrives, ahead of the database errors of earlier rows in the same
+// chunk, so the list is trimmed to the lowest indexes rather than cut off
+// at the first maxImportErrors rows listed.
+func (imp *refImport) addError(row *crud.RowError) {
+	imp.summary.Errors = append(imp.summary.Errors, row)
+	if len(imp.summary.Errors) >= 2*maxImportErrors {
+		imp.trimErrors()
+	}
+}
+
+// trimErrors sorts the listed errors by index and keeps the first
+// maxImportErrors.
+func (imp *refImport) trimErrors() {
+	errs := imp.summary.Errors
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Index < errs[j].Index })
+	if len(errs) > maxImportErrors {
+		imp.summary.Errors = errs[:maxImportErrors:maxImportErrors]
+	}
+}
+
+// commit writes the current chunk in one transaction. Rows rejected by the
+// database are skipped; when the commit itself fails every row of the chunk
+// is reported as failed, since none of them were persisted.
+func (imp *refImport) commit(ctx context.Context) {
+	if len(imp.rows) == 0 {
+		return
+	}
+	failures, err := imp.batch.CreateBatches(ctx, imp.rows, true)
+
+	if err != nil {
+		reason := "chunk commit failed: " + status.Convert(errorStatus(err)).Message()
+		for _, row := range imp.pending {
+			row.Reason = reason
+			imp.addError(row)
+		}
+		imp.summary.Failed += int64(len(imp.pending))
+	} else {
+		for _, failure := range failures {
+			row := imp.pending[failure.Index]
+			row.Reason = rowErrorReason(failure.Err)
+			imp.addError(row)
+		}
+		imp.summary.Failed += int64(len(failures))
+		imp.summary.Inserted += int64(len(imp.pending) - len(failures))
+	}
+	imp.rows, imp.pending = nil, nil
+}
+
+// ImportRef reads CreateBulkRef rows from the client stream and commits them
+// every importChunkSize rows. Rows rejected by the database are skipped and
+// the first maxImportErrors of them listed in the summary; if the stream breaks, the open chunk is dropped
+// while the chunks committed before it stay.
+func (s *server) ImportRef(stream crud.CrudService_ImportRefServer) error {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	imp := &refImport{batch: s.batch, summary: &crud.ImportRefResponse{}}
+
+	for index := int64(0); ; index++ {
+		data, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		imp.add(index, data)
+		if len(imp.rows) >= s.importChunkSize {
+			imp.commit(stream.Context())
+		}
+	}
+	imp.commit(stream.Context())
+	imp.trimErrors()
+
+	log.Printf("Import ref_barang: %d inserted, %d failed", imp.summary.Inserted, imp.summary.Failed)
+	logDuration(startTime, cpuStart)
+	return stream.SendAndClose(imp.summary)
+}
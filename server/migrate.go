@@ -0,0 +1,63 @@
+// server/migrate.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"grpc_crud/storage/sqlstore"
+	"strconv"
+)
+
+// runMigrate implements "migrate up", "migrate down [steps]" and
+// "migrate status" against the database selected by the storage flags.
+func runMigrate(backend string, opts storeOptions, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up | down [steps] | status")
+	}
+
+	db, dialect, err := openDB(backend, opts)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := sqlstore.MigrateUp(ctx, db, dialect)
+		for _, m := range applied {
+			fmt.Printf("applied  %04d_%s\n", m.Version, m.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps <= 0 {
+				return fmt.Errorf("migrate down: steps must be a positive number, got %q", args[1])
+			}
+		}
+		reverted, err := sqlstore.MigrateDown(ctx, db, dialect, steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %04d_%s\n", m.Version, m.Name)
+		}
+		return err
+	case "status":
+		migrations, err := sqlstore.MigrationStatus(ctx, db, dialect)
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			state := "pending"
+			if m.AppliedAt != "" {
+				state = "applied " + m.AppliedAt
+			}
+			fmt.Printf("%04d_%s\t%s\n", m.Version, m.Name, state)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown migrate command %q, want up, down or status", args[0])
+}
@@ -0,0 +1,52 @@
+// server/observability.go
+package main
+
+import (
+	"database/sql"
+	"expvar"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// publishDBStats exposes the pool statistics of db as the "db_stats" variable
+// of /debug/vars.
+func publishDBStats(db *sql.DB) {
+	expvar.Publish("db_stats", expvar.Func(func() interface{} {
+		return db.Stats()
+	}))
+}
+
+// logDBStats logs the pool statistics of db every interval. Wait counts that
+// keep growing mean MaxOpenConns is too low for the load.
+func logDBStats(db *sql.DB, interval time.Duration) {
+	logger := zap.L().Named("db")
+	for range time.Tick(interval) {
+		stats := db.Stats()
+		logger.Info("pool stats",
+			zap.Int("open", stats.OpenConnections),
+			zap.Int("in_use", stats.InUse),
+			zap.Int("idle", stats.Idle),
+			zap.Int64("wait_count", stats.WaitCount),
+			zap.Duration("wait_duration", stats.WaitDuration),
+			zap.Int64("max_idle_closed", stats.MaxIdleClosed),
+			zap.Int64("max_idle_time_closed", stats.MaxIdleTimeClosed),
+			zap.Int64("max_lifetime_closed", stats.MaxLifetimeClosed),
+		)
+	}
+}
+
+// serveDebug serves /debug/vars on addr. The listener is opened before
+// returning so that a busy address fails the startup.
+func serveDebug(addr string) error {
+	listen, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	go http.Serve(listen, mux)
+	return nil
+}
@@ -0,0 +1,88 @@
+// server/pagination.go
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"grpc_crud/storage"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// pageRequest is implemented by every Read* request.
+type pageRequest interface {
+	GetPageSize() int32
+	GetPageToken() string
+}
+
+// pageCursor is the content of a page token: the key of the last row of the
+// previous page. Value is only set when the rows are sorted by a column other
+// than the id, and holds that column of the last row. Query identifies the
+// listing the token was issued for, for RPCs whose order and filter the
+// client chooses; a token is only accepted for the same Query.
+type pageCursor struct {
+	Value string `json:"v,omitempty"`
+	ID    int64  `json:"id"`
+	Query string `json:"q,omitempty"`
+}
+
+// pageParams returns the page to ask the repository for and the number of
+// rows to return. The page limit is one more than pageSize so that a next
+// page can be detected without a second query. Pages are keyset based, so
+// rows inserted meanwhile never shift the next page. query is the Query the
+// page token must carry, "" for RPCs with a fixed order and filter.
+func pageParams(req pageRequest, query string) (page storage.Page, pageSize int, err error) {
+	pageSize = int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return storage.Page{}, 0, invalidArgument("page_size", "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	page.Limit = pageSize + 1
+
+	if req.GetPageToken() == "" {
+		return page, pageSize, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(req.GetPageToken())
+	if err != nil {
+		return storage.Page{}, 0, invalidArgument("page_token", "invalid page_token")
+	}
+	var after pageCursor
+	if err := json.Unmarshal(raw, &after); err != nil {
+		return storage.Page{}, 0, invalidArgument("page_token", "invalid page_token")
+	}
+	if after.Query != query {
+		return storage.Page{}, 0, invalidArgument("page_token", "page_token was issued for a different order_by or filter")
+	}
+	page.After = &storage.Cursor{Value: after.Value, ID: after.ID}
+	return page, pageSize, nil
+}
+
+func encodePageToken(last storage.Cursor, query string) string {
+	raw, _ := json.Marshal(pageCursor{Value: last.Value, ID: last.ID, Query: query})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// nextPage trims rows fetched with pageParams down to pageSize and returns
+// the token of the next page, or "" when rows was the last page.
+func nextPage[T any](rows []T, pageSize int, query string, cursor func(T) storage.Cursor) ([]T, string) {
+	if len(rows) <= pageSize {
+		return rows, ""
+	}
+	rows = rows[:pageSize]
+	return rows, encodePageToken(cursor(rows[len(rows)-1]), query)
+}
+
+func barangCursor(row storage.Barang) storage.Cursor {
+	return storage.Cursor{ID: row.IDBarang}
+}
+
+func batchCursor(row storage.Batch) storage.Cursor {
+	return storage.Cursor{ID: row.IDRefBarang}
+}
@@ -0,0 +1,67 @@
+// server/pagination_test.go
+package main
+
+import (
+	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPageParamsLimits(t *testing.T) {
+	for _, test := range []struct {
+		pageSize int32
+		want     int
+	}{
+		{0, defaultPageSize},
+		{1, 1},
+		{maxPageSize, maxPageSize},
+		{maxPageSize + 1, maxPageSize},
+	} {
+		page, pageSize, err := pageParams(&crud.ReadAllRequest{PageSize: test.pageSize}, "")
+		if err != nil || pageSize != test.want || page.Limit != test.want+1 || page.After != nil {
+			t.Errorf("pageParams(page_size %d) = %+v, %d, %v; want limit %d", test.pageSize, page, pageSize, err, test.want+1)
+		}
+	}
+
+	_, _, err := pageParams(&crud.ReadAllRequest{PageSize: -1}, "")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("pageParams(page_size -1) = %v, want InvalidArgument", err)
+	}
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	rows := []storage.Batch{{IDRefBarang: 3, Barang: storage.Barang{Harga: 1000}}, {IDRefBarang: 1, Barang: storage.Barang{Harga: 2000}}, {IDRefBarang: 2, Barang: storage.Barang{Harga: 3000}}}
+	cursor := readAllCursor(storage.ItemSort{Field: storage.SortByHarga})
+
+	if got, token := nextPage(rows, 3, "harga", cursor); len(got) != 3 || token != "" {
+		t.Errorf("nextPage of the last page = %d rows, token %q; want 3 rows, no token", len(got), token)
+	}
+
+	got, token := nextPage(rows, 2, "harga", cursor)
+	if len(got) != 2 || token == "" {
+		t.Fatalf("nextPage = %d rows, token %q; want 2 rows and a token", len(got), token)
+	}
+	page, _, err := pageParams(&crud.ReadAllRequest{PageToken: token}, "harga")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.After == nil || *page.After != (storage.Cursor{Value: "2000", ID: 1}) {
+		t.Errorf("cursor of the next page = %+v, want the second row", page.After)
+	}
+
+	for _, test := range []struct {
+		name, token, query string
+	}{
+		{"other query", token, "harga desc"},
+		{"no query", token, ""},
+		{"not base64", "!!", "harga"},
+		{"not json", "bm90IGpzb24", "harga"},
+	} {
+		if _, _, err := pageParams(&crud.ReadAllRequest{PageToken: test.token}, test.query); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: pageParams = %v, want InvalidArgument", test.name, err)
+		}
+	}
+}
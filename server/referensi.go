@@ -0,0 +1,195 @@
+// server/referensi.go
+package main
+
+import (
+	"context"
+	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
+	"time"
+)
+
+type referensiServer struct {
+	referensi                                storage.ReferensiRepository
+	crud.UnimplementedReferensiServiceServer // Embed the UnimplementedReferensiServiceServer
+}
+
+func (s *referensiServer) list(ctx context.Context, lookup storage.Lookup) ([]storage.LookupRow, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	rows, err := s.referensi.ListLookup(ctx, lookup)
+	if err != nil {
+		return nil, err
+	}
+
+	logDuration(startTime, cpuStart)
+	return rows, nil
+}
+
+func (s *referensiServer) create(ctx context.Context, lookup storage.Lookup, nama string) (storage.LookupRow, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	row, err := s.referensi.CreateLookup(ctx, lookup, nama)
+	if err != nil {
+		return storage.LookupRow{}, err
+	}
+
+	logDuration(startTime, cpuStart)
+	return row, nil
+}
+
+func (s *referensiServer) rename(ctx context.Context, lookup storage.Lookup, id int64, nama string) (storage.LookupRow, error) {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	row, err := s.referensi.RenameLookup(ctx, lookup, id, nama)
+	if err != nil {
+		return storage.LookupRow{}, err
+	}
+
+	logDuration(startTime, cpuStart)
+	return row, nil
+}
+
+// retire deletes the row, refusing while any barang still points to it.
+func (s *referensiServer) retire(ctx context.Context, lookup storage.Lookup, id int64) error {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	if err := s.referensi.DeleteLookup(ctx, lookup, id); err != nil {
+		return err
+	}
+
+	logDuration(startTime, cpuStart)
+	return nil
+}
+
+func (s *referensiServer) ListKategori(ctx context.Context, req *crud.ListKategoriRequest) (*crud.ListKategoriResponse, error) {
+	rows, err := s.list(ctx, storage.Kategori)
+	if err != nil {
+		return nil, err
+	}
+	var responses []*crud.Kategori
+	for _, row := range rows {
+		responses = append(responses, &crud.Kategori{IdKategori: row.ID, NamaKategori: row.Nama})
+	}
+	return &crud.ListKategoriResponse{Responses: responses}, nil
+}
+
+func (s *referensiServer) CreateKategori(ctx context.Context, req *crud.CreateKategoriRequest) (*crud.CreateKategoriResponse, error) {
+	row, err := s.create(ctx, storage.Kategori, req.NamaKategori)
+	if err != nil {
+		return nil, err
+	}
+	return &crud.CreateKategoriResponse{
+		Success:  true,
+		Message:  "Kategori created successfully",
+		Kategori: &crud.Kategori{IdKategori: row.ID, NamaKategori: row.Nama},
+	}, nil
+}
+
+func (s *referensiServer) UpdateKategori(ctx context.Context, req *crud.UpdateKategoriRequest) (*crud.UpdateKategoriResponse, error) {
+	row, err := s.rename(ctx, storage.Kategori, req.IdKategori, req.NamaKategori)
+	if err != nil {
+		return nil, err
+	}
+	return &crud.UpdateKategoriResponse{
+		Success:  true,
+		Message:  "Kategori updated successfully",
+		Kategori: &crud.Kategori{IdKategori: row.ID, NamaKategori: row.Nama},
+	}, nil
+}
+
+func (s *referensiServer) DeleteKategori(ctx context.Context, req *crud.DeleteKategoriRequest) (*crud.DeleteKategoriResponse, error) {
+	if err := s.retire(ctx, storage.Kategori, req.IdKategori); err != nil {
+		return nil, err
+	}
+	return &crud.DeleteKategoriResponse{Success: true, Message: "Kategori deleted successfully"}, nil
+}
+
+func (s *referensiServer) ListJenis(ctx context.Context, req *crud.ListJenisRequest) (*crud.ListJenisResponse, error) {
+	rows, err := s.list(ctx, storage.Jenis)
+	if err != nil {
+		return nil, err
+	}
+	var responses []*crud.Jenis
+	for _, row := range rows {
+		responses = append(responses, &crud.Jenis{IdJenis: row.ID, NamaJenis: row.Nama})
+	}
+	return &crud.ListJenisResponse{Responses: responses}, nil
+}
+
+func (s *referensiServer) CreateJenis(ctx context.Context, req *crud.CreateJenisRequest) (*crud.CreateJenisResponse, error) {
+	row, err := s.create(ctx, storage.Jenis, req.NamaJenis)
+	if err != nil {
+		return nil, err
+	}
+	return &crud.CreateJenisResponse{
+		Success: true,
+		Message: "Jenis created successfully",
+		Jenis:   &crud.Jenis{IdJenis: row.ID, NamaJenis: row.Nama},
+	}, nil
+}
+
+func (s *referensiServer) UpdateJenis(ctx context.Context, req *crud.UpdateJenisRequest) (*crud.UpdateJenisResponse, error) {
+	row, err := s.rename(ctx, storage.Jenis, req.IdJenis, req.NamaJenis)
+	if err != nil {
+		return nil, err
+	}
+	return &crud.UpdateJenisResponse{
+		Success: true,
+		Message: "Jenis updated successfully",
+		Jenis:   &crud.Jenis{IdJenis: row.ID, NamaJenis: row.Nama},
+	}, nil
+}
+
+func (s *referensiServer) DeleteJenis(ctx context.Context, req *crud.DeleteJenisRequest) (*crud.DeleteJenisResponse, error) {
+	if err := s.retire(ctx, storage.Jenis, req.IdJenis); err != nil {
+		return nil, err
+	}
+	return &crud.DeleteJenisResponse{Success: true, Message: "Jenis deleted successfully"}, nil
+}
+
+func (s *referensiServer) ListMaterial(ctx context.Context, req *crud.ListMaterialRequest) (*crud.ListMaterialResponse, error) {
+	rows, err := s.list(ctx, storage.Material)
+	if err != nil {
+		return nil, err
+	}
+	var responses []*crud.Material
+	for _, row := range rows {
+		responses = append(responses, &crud.Material{IdMaterial: row.ID, NamaMaterial: row.Nama})
+	}
+	return &crud.ListMaterialResponse{Responses: responses}, nil
+}
+
+func (s *referensiServer) CreateMaterial(ctx context.Context, req *crud.CreateMaterialRequest) (*crud.CreateMaterialResponse, error) {
+	row, err := s.create(ctx, storage.Material, req.NamaMaterial)
+	if err != nil {
+		return nil, err
+	}
+	return &crud.CreateMaterialResponse{
+		Success:  true,
+		Message:  "Material created successfully",
+		Material: &crud.Material{IdMaterial: row.ID, NamaMaterial: row.Nama},
+	}, nil
+}
+
+func (s *referensiServer) UpdateMaterial(ctx context.Context, req *crud.UpdateMaterialRequest) (*crud.UpdateMaterialResponse, error) {
+	row, err := s.rename(ctx, storage.Material, req.IdMaterial, req.NamaMaterial)
+	if err != nil {
+		return nil, err
+	}
+	return &crud.UpdateMaterialResponse{
+		Success:  true,
+		Message:  "Material updated successfully",
+		Material: &crud.Material{IdMaterial: row.ID, NamaMaterial: row.Nama},
+	}, nil
+}
+
+func (s *referensiServer) DeleteMaterial(ctx context.Context, req *crud.DeleteMaterialRequest) (*crud.DeleteMaterialResponse, error) {
+	if err := s.retire(ctx, storage.Material, req.IdMaterial); err != nil {
+		return nil, err
+	}
+	return &crud.DeleteMaterialResponse{Success: true, Message: "Material deleted successfully"}, nil
+}
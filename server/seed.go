@@ -0,0 +1,176 @@
+// server/seed.go
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"grpc_crud/storage"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Word lists the synthetic barang names are built from.
+var (
+	seedKategori = []string{"Makanan", "Minuman", "Bumbu Dapur", "Perawatan Diri", "Kebersihan Rumah", "Makanan Bayi", "Obat Bebas", "Makanan Beku"}
+	seedJenis    = []string{"Kemasan", "Botol", "Sachet", "Kaleng", "Kardus", "Curah"}
+	seedMaterial = []string{"Plastik", "Kaca", "Kertas", "Aluminium", "Karton"}
+	seedProduk   = []string{"Biskuit", "Teh", "Kopi", "Kecap", "Sampo", "Sabun", "Mie", "Susu", "Saus", "Minyak", "Gula", "Roti", "Keripik", "Sirup", "Deterjen"}
+	seedVarian   = []string{"Kelapa", "Melati", "Coklat", "Pedas", "Original", "Jeruk", "Vanila", "Stroberi", "Lidah Buaya", "Mint", "Manis", "Asin"}
+	seedUkuran   = []string{"100g", "250g", "500g", "1kg", "250ml", "600ml", "1L"}
+)
+
+// seedChunkSize is the number of lots written per CreateBatches call.
+const seedChunkSize = 1000
+
+// runSeed implements "seed": it fills an empty database with a synthetic
+// dataset of controlled size for benchmark runs. The same -rand-seed and
+// -base-date always produce the same rows; batch numbers are built from the
+// position of the barang in the run, not its generated id, so they do not
+// depend on what the database held before.
+func runSeed(store storage.Store, today time.Time, args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	rows := fs.Int("rows", 10000, "number of ref_barang lots to create")
+	barangCount := fs.Int("barang", 0, "number of barang the lots are spread over (default rows/5)")
+	randSeed := fs.Int64("rand-seed", 1, "seed of the random generator")
+	baseDate := fs.String("base-date", today.Format(dateLayout), "date the expiry dates are relative to, YYYY-MM-DD")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *rows <= 0 {
+		return fmt.Errorf("rows must be positive, got %d", *rows)
+	}
+	if *barangCount == 0 {
+		*barangCount = max(*rows/5, 1)
+	}
+	if *barangCount < 0 {
+		return fmt.Errorf("barang must not be negative, got %d", *barangCount)
+	}
+	base, err := time.Parse(dateLayout, *baseDate)
+	if err != nil {
+		return fmt.Errorf("base-date %q is not a YYYY-MM-DD date", *baseDate)
+	}
+
+	ctx := context.Background()
+	if err := checkSeedEmpty(ctx, store); err != nil {
+		return err
+	}
+	rng := rand.New(rand.NewSource(*randSeed))
+	startTime := time.Now()
+
+	lookupIDs := map[storage.Lookup][]int64{}
+	for _, l := range []struct {
+		lookup storage.Lookup
+		names  []string
+	}{
+		{storage.Kategori, seedKategori},
+		{storage.Jenis, seedJenis},
+		{storage.Material, seedMaterial},
+	} {
+		for _, nama := range l.names {
+			row, err := store.CreateLookup(ctx, l.lookup, nama)
+			if err != nil {
+				return err
+			}
+			lookupIDs[l.lookup] = append(lookupIDs[l.lookup], row.ID)
+		}
+	}
+
+	type seededBarang struct {
+		id int64
+		// number is the position in this run, 1-based.
+		number int
+		code   string
+	}
+	barang := make([]seededBarang, 0, *barangCount)
+	for i := 0; i < *barangCount; i++ {
+		produk := seedProduk[rng.Intn(len(seedProduk))]
+		nama := produk + " " + seedVarian[rng.Intn(len(seedVarian))] + " " + seedUkuran[rng.Intn(len(seedUkuran))]
+		// Prices cluster at the low end like a small shop's shelf, in
+		// steps of Rp500.
+		harga := int32(1000 + 500*int(rng.ExpFloat64()*20))
+		id, err := store.CreateBarang(ctx, storage.NewBarang{
+			NamaBarang: nama,
+			FotoBarang: strings.ToLower(strings.ReplaceAll(nama, " ", "-")) + ".jpg",
+			Harga:      harga,
+			IDKategori: lookupIDs[storage.Kategori][rng.Intn(len(lookupIDs[storage.Kategori]))],
+			IDJenis:    lookupIDs[storage.Jenis][rng.Intn(len(lookupIDs[storage.Jenis]))],
+			IDMaterial: lookupIDs[storage.Material][rng.Intn(len(lookupIDs[storage.Material]))],
+		})
+		if err != nil {
+			return err
+		}
+		barang = append(barang, seededBarang{id: id, number: i + 1, code: strings.ToUpper(produk[:3])})
+	}
+
+	lots := make([]int, len(barang)) // lots created so far per barang
+	var chunk []storage.NewBatch
+	for i := 0; i < *rows; i++ {
+		n := rng.Intn(len(barang))
+		b := barang[n]
+		lots[n]++
+
+		expired := base.AddDate(0, 0, seedExpiryOffset(rng))
+		// Batch numbers carry the production month, a few months to two
+		// years before expiry, and a running lot number per barang.
+		produced := expired.AddDate(0, -(3 + rng.Intn(22)), 0)
+		chunk = append(chunk, storage.NewBatch{
+			IDBarang: b.id,
+			Stok:     int32(1 + rng.ExpFloat64()*80),
+			Expired:  expired.Format(dateLayout),
+			NoBatch:  fmt.Sprintf("%s%04d-%s-%03d", b.code, b.number, produced.Format("0601"), lots[n]),
+		})
+
+		if len(chunk) == seedChunkSize || i == *rows-1 {
+			failures, err := store.CreateBatches(ctx, chunk, false)
+			if err != nil {
+				return err
+			}
+			if len(failures) > 0 {
+				return fmt.Errorf("lot %d: %w", i-len(chunk)+1+failures[0].Index, failures[0].Err)
+			}
+			chunk = chunk[:0]
+		}
+	}
+
+	log.Printf("Seeded %d barang and %d ref_barang with rand-seed %d, base-date %s", len(barang), *rows, *randSeed, base.Format(dateLayout))
+	log.Printf("Durasi eksekusi: %v", time.Since(startTime))
+	return nil
+}
+
+// checkSeedEmpty refuses to seed a database that already has data. Seeding
+// on top of it would duplicate the lookup rows and mix the synthetic rows
+// with others, so a benchmark would not run on the same data twice. Barang
+// and lots cannot exist without lookup rows, so those are all it checks.
+func checkSeedEmpty(ctx context.Context, store storage.Store) error {
+	var found []string
+	for _, lookup := range []storage.Lookup{storage.Kategori, storage.Jenis, storage.Material} {
+		rows, err := store.ListLookup(ctx, lookup)
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			found = append(found, fmt.Sprintf("%d %s", len(rows), lookup))
+		}
+	}
+	if len(found) > 0 {
+		return fmt.Errorf("seed needs empty tables but found %s; revert every migration with migrate down and apply them again with migrate up first", strings.Join(found, ", "))
+	}
+	return nil
+}
+
+// seedExpiryOffset draws the days until expiry: about 10% of the lots are
+// already expired, 15% expire within a month and the rest follow a long
+// tail of shelf lives up to two years.
+func seedExpiryOffset(rng *rand.Rand) int {
+	switch p := rng.Float64(); {
+	case p < 0.10:
+		return -1 - rng.Intn(180)
+	case p < 0.25:
+		return rng.Intn(31)
+	default:
+		return min(31+int(rng.ExpFloat64()*180), 730)
+	}
+}
@@ -3,38 +3,40 @@ package main
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
 	"log"
 	"net"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/bufbuild/protovalidate-go"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"runtime"
 	"time"
+	_ "time/tzdata"
 
-	_ "github.com/go-sql-driver/mysql"
 	"google.golang.org/grpc"
-)
-
-const (
-	port   = ":50051"
-	dbHost = "localhost"
-	dbPort = "3306"
-	dbUser = "root"
-	dbPass = ""
-	dbName = "db_skripsi_2"
+	"google.golang.org/grpc/status"
 )
 
 type server struct {
-	db                                  *sql.DB
+	barang          storage.BarangRepository
+	batch           storage.BatchRepository
+	importChunkSize int
+	// location is the business timezone that decides what "today" is.
+	location                            *time.Location
 	crud.UnimplementedCrudServiceServer // Embed the UnimplementedCrudServiceServer
 }
 
@@ -44,6 +46,17 @@ func getCurrentCPUUsage() float64 {
 	return float64(stat.TotalAlloc) / 1024.0 / 1024.0
 }
 
+// logDuration logs the execution time of a handler and the memory it
+// allocated since startTime and cpuStart were taken.
+func logDuration(startTime time.Time, cpuStart float64) {
+	cpuEnd := getCurrentCPUUsage()
+
+	duration := time.Since(startTime)
+	cpuUsage := cpuEnd - cpuStart
+
+	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
+}
+
 func measureResponseSizeInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		// Save the start time to measure duration
@@ -77,43 +90,69 @@ func calculateMemorySize(obj interface{}) int {
 	return len(jsonData)
 }
 
+func responseRead(row storage.Batch) *crud.ResponseRead {
+	return &crud.ResponseRead{
+		NamaBarang:   row.NamaBarang,
+		FotoBarang:   row.FotoBarang,
+		Harga:        row.Harga,
+		NamaKategori: row.NamaKategori,
+		NamaJenis:    row.NamaJenis,
+		NoBatch:      row.NoBatch,
+	}
+}
+
+func responseReadExpired(row storage.Batch) *crud.ResponseReadExpired {
+	return &crud.ResponseReadExpired{
+		NamaBarang: row.NamaBarang,
+		NomorBatch: row.NoBatch,
+		Stok:       row.Stok,
+		TglExpired: row.Expired,
+	}
+}
+
+func responseReadNotExpired(row storage.Batch) *crud.ResponseReadNotExpired {
+	return &crud.ResponseReadNotExpired{
+		NamaBarang: row.NamaBarang,
+		NomorBatch: row.NoBatch,
+		Stok:       row.Stok,
+		TglExpired: row.Expired,
+	}
+}
+
 func (s *server) ReadAll(ctx context.Context, req *crud.ReadAllRequest) (*crud.ReadAllResponse, error) {
 
 	startTime := time.Now()
 	cpuStart := getCurrentCPUUsage()
 
-	rows, err := s.db.Query("SELECT b.nama_barang, b.foto_barang, b.harga, k.nama_kategori, j.nama_jenis, rb.no_batch FROM ref_barang rb INNER JOIN barang b ON rb.id_barang = b.id_barang INNER JOIN kategori k ON b.id_kategori = k.id_kategori INNER JOIN material m ON b.id_material = m.id_material INNER JOIN jenis j ON b.id_jenis = j.id_jenis")
+	order, err := parseReadAllOrderBy(req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := readAllFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	query := readAllQuery(order, filter)
+	page, pageSize, err := pageParams(req, query)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkReadAllCursor(order, page.After); err != nil {
+		return nil, err
+	}
+
+	rows, totalSize, err := s.batch.ListItems(ctx, filter, order, page)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	rows, nextPageToken := nextPage(rows, pageSize, query, readAllCursor(order))
 
 	var responses []*crud.ResponseRead
 
 	var totalMemorySize int
 
-	for rows.Next() {
-		var namaBarang, fotoBarang, namaKategori, namaJenis, noBatch string
-		var harga string
-
-		err := rows.Scan(&namaBarang, &fotoBarang, &harga, &namaKategori, &namaJenis, &noBatch)
-		if err != nil {
-			return nil, err
-		}
-
-		hargaInt, err := strconv.Atoi(harga)
-		if err != nil {
-			return nil, err
-		}
-
-		response := &crud.ResponseRead{
-			NamaBarang:   namaBarang,
-			FotoBarang:   fotoBarang,
-			Harga:        int32(hargaInt),
-			NamaKategori: namaKategori,
-			NamaJenis:    namaJenis,
-			NoBatch:      noBatch,
-		}
+	for _, row := range rows {
+		response := responseRead(row)
 		//iki gae per objek e temporary gae fungsi calculateMemorySize ( jo lali fungsine dicopas sisan )
 		responseMemorySize := calculateMemorySize(response)
 
@@ -122,52 +161,36 @@ func (s *server) ReadAll(ctx context.Context, req *crud.ReadAllRequest) (*crud.R
 		responses = append(responses, response)
 	}
 
-	cpuEnd := getCurrentCPUUsage()
-
-	duration := time.Since(startTime)
-	cpuUsage := cpuEnd - cpuStart
-
 	log.Printf("Total ukuran memori respons: %d bytes", totalMemorySize)
-	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
+	logDuration(startTime, cpuStart)
 
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return &crud.ReadAllResponse{Responses: responses}, nil
+	return &crud.ReadAllResponse{Responses: responses, NextPageToken: nextPageToken, TotalSize: totalSize}, nil
 }
 
 func (s *server) ReadWithCategory(ctx context.Context, req *crud.ReadWithCategoryRequest) (*crud.ReadWithCategoryResponse, error) {
 	startTime := time.Now()
 	cpuStart := getCurrentCPUUsage()
-	rows, err := s.db.Query("SELECT b.nama_barang, b.foto_barang, k.nama_kategori, b.harga FROM barang b INNER JOIN kategori k on b.id_kategori = k.id_kategori")
+	page, pageSize, err := pageParams(req, "")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, totalSize, err := s.barang.ListBarangByLookup(ctx, storage.Kategori, page)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	rows, nextPageToken := nextPage(rows, pageSize, "", barangCursor)
 
 	var responses []*crud.ResponseReadCategory
 
 	var totalMemorySize int
 
-	for rows.Next() {
-		var namaBarang, fotoBarang, namaKategori string
-		var harga string
-		err := rows.Scan(&namaBarang, &fotoBarang, &namaKategori, &harga)
-		if err != nil {
-			return nil, err
-		}
-
-		hargaInt, err := strconv.Atoi(harga)
-		if err != nil {
-			return nil, err
-		}
-
+	for _, row := range rows {
 		response := &crud.ResponseReadCategory{
-			NamaBarang:   namaBarang,
-			FotoBarang:   fotoBarang,
-			Harga:        int32(hargaInt),
-			NamaKategori: namaKategori,
+			NamaBarang:   row.NamaBarang,
+			FotoBarang:   row.FotoBarang,
+			Harga:        row.Harga,
+			NamaKategori: row.NamaKategori,
 		}
 
 		responseMemorySize := calculateMemorySize(response)
@@ -175,53 +198,36 @@ func (s *server) ReadWithCategory(ctx context.Context, req *crud.ReadWithCategor
 		responses = append(responses, response)
 	}
 
-	cpuEnd := getCurrentCPUUsage()
-
-	duration := time.Since(startTime)
-	cpuUsage := cpuEnd - cpuStart
-
-	// Log atau lakukan sesuatu dengan informasi penggunaan CPU
 	log.Printf("Total ukuran memori respons: %d bytes", totalMemorySize)
-	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
-
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
+	logDuration(startTime, cpuStart)
 
-	return &crud.ReadWithCategoryResponse{Responses: responses}, nil
+	return &crud.ReadWithCategoryResponse{Responses: responses, NextPageToken: nextPageToken, TotalSize: totalSize}, nil
 }
 
 func (s *server) ReadWithJenis(ctx context.Context, req *crud.ReadWithJenisRequest) (*crud.ReadWithJenisResponse, error) {
 	startTime := time.Now()
 	cpuStart := getCurrentCPUUsage()
-	rows, err := s.db.Query("SELECT b.nama_barang, b.foto_barang, k.nama_jenis, b.harga FROM barang b INNER JOIN jenis k on b.id_jenis = k.id_jenis")
+	page, pageSize, err := pageParams(req, "")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, totalSize, err := s.barang.ListBarangByLookup(ctx, storage.Jenis, page)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	rows, nextPageToken := nextPage(rows, pageSize, "", barangCursor)
 
 	var responses []*crud.ResponseReadJenis
 
 	var totalMemorySize int
 
-	for rows.Next() {
-		var namaBarang, fotoBarang, namaJenis string
-		var harga string
-		err := rows.Scan(&namaBarang, &fotoBarang, &namaJenis, &harga)
-		if err != nil {
-			return nil, err
-		}
-
-		hargaInt, err := strconv.Atoi(harga)
-		if err != nil {
-			return nil, err
-		}
-
+	for _, row := range rows {
 		response := &crud.ResponseReadJenis{
-			NamaBarang: namaBarang,
-			FotoBarang: fotoBarang,
-			Harga:      int32(hargaInt),
-			NamaJenis:  namaJenis,
+			NamaBarang: row.NamaBarang,
+			FotoBarang: row.FotoBarang,
+			Harga:      row.Harga,
+			NamaJenis:  row.NamaJenis,
 		}
 
 		responseMemorySize := calculateMemorySize(response)
@@ -229,322 +235,387 @@ func (s *server) ReadWithJenis(ctx context.Context, req *crud.ReadWithJenisReque
 		responses = append(responses, response)
 	}
 
-	cpuEnd := getCurrentCPUUsage()
-
-	duration := time.Since(startTime)
-	cpuUsage := cpuEnd - cpuStart
-
-	// Log atau lakukan sesuatu dengan informasi penggunaan CPU
 	log.Printf("Total ukuran memori respons: %d bytes", totalMemorySize)
-	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
+	logDuration(startTime, cpuStart)
 
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return &crud.ReadWithJenisResponse{Responses: responses}, nil
+	return &crud.ReadWithJenisResponse{Responses: responses, NextPageToken: nextPageToken, TotalSize: totalSize}, nil
 }
 
 func (s *server) ReadWithMaterial(ctx context.Context, req *crud.ReadWithMaterialRequest) (*crud.ReadWithMaterialResponse, error) {
 	startTime := time.Now()
 	cpuStart := getCurrentCPUUsage()
-	rows, err := s.db.Query("SELECT b.nama_barang, b.foto_barang, k.nama_material, b.harga FROM barang b INNER JOIN material k on b.id_material = k.id_material")
+	page, pageSize, err := pageParams(req, "")
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-	var responses []*crud.ResponseReadMaterial
 
-	var totalMemorySize int
-
-	for rows.Next() {
-		var namaBarang, fotoBarang, namaMaterial string
-		var harga string
+	rows, totalSize, err := s.barang.ListBarangByLookup(ctx, storage.Material, page)
+	if err != nil {
+		return nil, err
+	}
+	rows, nextPageToken := nextPage(rows, pageSize, "", barangCursor)
 
-		err := rows.Scan(&namaBarang, &fotoBarang, &namaMaterial, &harga)
-		if err != nil {
-			return nil, err
-		}
+	var responses []*crud.ResponseReadMaterial
 
-		hargaInt, err := strconv.Atoi(harga)
-		if err != nil {
-			return nil, err
-		}
+	var totalMemorySize int
 
+	for _, row := range rows {
 		response := &crud.ResponseReadMaterial{
-			NamaBarang:   namaBarang,
-			FotoBarang:   fotoBarang,
-			Harga:        int32(hargaInt),
-			NamaMaterial: namaMaterial,
+			NamaBarang:   row.NamaBarang,
+			FotoBarang:   row.FotoBarang,
+			Harga:        row.Harga,
+			NamaMaterial: row.NamaMaterial,
 		}
 		responseMemorySize := calculateMemorySize(response)
 		totalMemorySize += responseMemorySize
 		responses = append(responses, response)
 	}
 
-	cpuEnd := getCurrentCPUUsage()
-
-	duration := time.Since(startTime)
-	cpuUsage := cpuEnd - cpuStart
-
-	// Log atau lakukan sesuatu dengan informasi penggunaan CPU
 	log.Printf("Total ukuran memori respons: %d bytes", totalMemorySize)
-	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
+	logDuration(startTime, cpuStart)
 
-	return &crud.ReadWithMaterialResponse{Responses: responses}, nil
+	return &crud.ReadWithMaterialResponse{Responses: responses, NextPageToken: nextPageToken, TotalSize: totalSize}, nil
 }
 
 func (s *server) ReadWithBatch(ctx context.Context, req *crud.ReadWithBatchRequest) (*crud.ReadWithBatchResponse, error) {
 	startTime := time.Now()
 	cpuStart := getCurrentCPUUsage()
-	rows, err := s.db.Query("SELECT b.nama_barang, b.foto_barang, k.no_batch, b.harga FROM barang b INNER JOIN ref_barang k on b.id_barang = k.id_barang")
+	page, pageSize, err := pageParams(req, "")
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+
+	rows, totalSize, err := s.batch.ListBatches(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	rows, nextPageToken := nextPage(rows, pageSize, "", batchCursor)
 
 	var responses []*crud.ResponseReadBatch
 
 	var totalMemorySize int
 
-	for rows.Next() {
-		var namaBarang, fotoBarang, NoBatch string
-		var harga string
-		err := rows.Scan(&namaBarang, &fotoBarang, &NoBatch, &harga)
-		if err != nil {
-			return nil, err
-		}
-
-		hargaInt, err := strconv.Atoi(harga)
-		if err != nil {
-			return nil, err
-		}
+	for _, row := range rows {
 		response := &crud.ResponseReadBatch{
-			NamaBarang: namaBarang,
-			FotoBarang: fotoBarang,
-			Harga:      int32(hargaInt),
-			NomorBatch: NoBatch,
+			NamaBarang: row.NamaBarang,
+			FotoBarang: row.FotoBarang,
+			Harga:      row.Harga,
+			NomorBatch: row.NoBatch,
 		}
 		responseMemorySize := calculateMemorySize(response)
 		totalMemorySize += responseMemorySize
 		responses = append(responses, response)
 	}
 
-	cpuEnd := getCurrentCPUUsage()
-
-	duration := time.Since(startTime)
-	cpuUsage := cpuEnd - cpuStart
-
-	// Log atau lakukan sesuatu dengan informasi penggunaan CPU
 	log.Printf("Total ukuran memori respons: %d bytes", totalMemorySize)
-	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
+	logDuration(startTime, cpuStart)
 
-	return &crud.ReadWithBatchResponse{Responses: responses}, nil
+	return &crud.ReadWithBatchResponse{Responses: responses, NextPageToken: nextPageToken, TotalSize: totalSize}, nil
 }
 
 func (s *server) ReadExpiredBarang(ctx context.Context, req *crud.ReadExpiredBarangRequest) (*crud.ReadExpiredBarangResponse, error) {
 	startTime := time.Now()
 	cpuStart := getCurrentCPUUsage()
-	rows, err := s.db.Query("SELECT b.nama_barang, rb.stok, rb.no_batch, rb.expired FROM ref_barang rb INNER JOIN barang b ON rb.id_barang = b.id_barang  WHERE rb.expired <= CURRENT_DATE")
+	page, pageSize, err := pageParams(req, "")
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	var responses []*crud.ResponseReadExpired
 
-	var totalMemorySize int
+	asOf, err := s.businessDate(req.AsOf)
+	if err != nil {
+		return nil, err
+	}
 
-	for rows.Next() {
-		var namaBarang, stok, NoBatch, tglExpired string
+	rows, totalSize, err := s.batch.ListExpired(ctx, asOf.Format(dateLayout), page)
+	if err != nil {
+		return nil, err
+	}
+	rows, nextPageToken := nextPage(rows, pageSize, "", batchCursor)
 
-		err := rows.Scan(&namaBarang, &stok, &NoBatch, &tglExpired)
-		if err != nil {
-			return nil, err
-		}
+	var responses []*crud.ResponseReadExpired
 
-		stokInt, err := strconv.Atoi(stok)
-		if err != nil {
-			return nil, err
-		}
+	var totalMemorySize int
 
-		response := &crud.ResponseReadExpired{
-			NamaBarang: namaBarang,
-			NomorBatch: NoBatch,
-			Stok:       int32(stokInt),
-			TglExpired: tglExpired,
-		}
+	for _, row := range rows {
+		response := responseReadExpired(row)
 		responseMemorySize := calculateMemorySize(response)
 		totalMemorySize += responseMemorySize
 		responses = append(responses, response)
 	}
-	cpuEnd := getCurrentCPUUsage()
-
-	duration := time.Since(startTime)
-	cpuUsage := cpuEnd - cpuStart
-
-	// Log atau lakukan sesuatu dengan informasi penggunaan CPU
 	log.Printf("Total ukuran memori respons: %d bytes", totalMemorySize)
-	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
+	logDuration(startTime, cpuStart)
 
-	return &crud.ReadExpiredBarangResponse{Responses: responses}, nil
+	return &crud.ReadExpiredBarangResponse{Responses: responses, NextPageToken: nextPageToken, TotalSize: totalSize}, nil
 }
 
 func (s *server) ReadNotExpiredBarang(ctx context.Context, req *crud.ReadNotExpiredBarangRequest) (*crud.ReadNotExpiredBarangResponse, error) {
 	startTime := time.Now()
 	cpuStart := getCurrentCPUUsage()
-	rows, err := s.db.Query("SELECT b.nama_barang, rb.stok, rb.no_batch, rb.expired FROM ref_barang rb INNER JOIN barang b ON rb.id_barang = b.id_barang  WHERE rb.expired >= CURRENT_DATE")
+	page, pageSize, err := pageParams(req, "")
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-	var responses []*crud.ResponseReadNotExpired
 
-	var totalMemorySize int
+	asOf, err := s.businessDate(req.AsOf)
+	if err != nil {
+		return nil, err
+	}
 
-	for rows.Next() {
-		var namaBarang, stok, NoBatch, tglExpired string
+	rows, totalSize, err := s.batch.ListNotExpired(ctx, asOf.Format(dateLayout), page)
+	if err != nil {
+		return nil, err
+	}
+	rows, nextPageToken := nextPage(rows, pageSize, "", batchCursor)
 
-		err := rows.Scan(&namaBarang, &stok, &NoBatch, &tglExpired)
-		if err != nil {
-			return nil, err
-		}
+	var responses []*crud.ResponseReadNotExpired
 
-		stokInt, err := strconv.Atoi(stok)
-		if err != nil {
-			return nil, err
-		}
+	var totalMemorySize int
 
-		response := &crud.ResponseReadNotExpired{
-			NamaBarang: namaBarang,
-			NomorBatch: NoBatch,
-			Stok:       int32(stokInt),
-			TglExpired: tglExpired,
-		}
+	for _, row := range rows {
+		response := responseReadNotExpired(row)
 		responseMemorySize := calculateMemorySize(response)
 		totalMemorySize += responseMemorySize
 		responses = append(responses, response)
 	}
 
-	cpuEnd := getCurrentCPUUsage()
-
-	duration := time.Since(startTime)
-	cpuUsage := cpuEnd - cpuStart
-
-	// Log atau lakukan sesuatu dengan informasi penggunaan CPU
 	log.Printf("Total ukuran memori respons: %d bytes", totalMemorySize)
-	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
+	logDuration(startTime, cpuStart)
 
-	return &crud.ReadNotExpiredBarangResponse{Responses: responses}, nil
+	return &crud.ReadNotExpiredBarangResponse{Responses: responses, NextPageToken: nextPageToken, TotalSize: totalSize}, nil
 }
 
 func (s *server) UpdateHargaBatch(ctx context.Context, req *crud.UpdateHargaBatchRequest) (*crud.UpdateHargaBatchResponse, error) {
 	startTime := time.Now()
 	cpuStart := getCurrentCPUUsage()
-	_, err := s.db.Exec("UPDATE barang b INNER JOIN ref_barang rb ON rb.id_barang = b.id_barang SET b.harga =? WHERE rb.no_batch =?", req.Harga, req.NomorBatch)
+	err := s.barang.UpdateHargaByBatch(ctx, req.NomorBatch, req.Harga)
 	if err != nil {
 		return nil, err
 	}
-	cpuEnd := getCurrentCPUUsage()
+	logDuration(startTime, cpuStart)
+	return &crud.UpdateHargaBatchResponse{Success: true, Message: "Data updated successfully"}, nil
+}
 
-	duration := time.Since(startTime)
-	cpuUsage := cpuEnd - cpuStart
+// legacyExpiredLayouts are the exp_date formats MySQL accepted for the
+// string CreateBulkRef rows; only the date part is kept.
+var legacyExpiredLayouts = []string{dateLayout, "2006-01-02 15:04:05"}
 
-	// Log atau lakukan sesuatu dengan informasi penggunaan CPU
-	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
-	return &crud.UpdateHargaBatchResponse{Success: true, Message: "Data updated successfully"}, nil
+// maxNoBatchLen is the width of ref_barang.no_batch, the max_len of the
+// no_batch request fields. Bulk rows have no constraints, so their no_batch
+// is checked against it by hand.
+const maxNoBatchLen = 50
+
+// newBatchFromLegacy parses the string fields of a CreateBulkRef.
+func newBatchFromLegacy(data *crud.CreateBulkRef) (storage.NewBatch, error) {
+	if utf8.RuneCountInString(data.NoBatch) > maxNoBatchLen {
+		return storage.NewBatch{}, fmt.Errorf("no_batch is longer than %d characters", maxNoBatchLen)
+	}
+	idBarang, err := strconv.ParseInt(strings.TrimSpace(data.IdBarang), 10, 64)
+	if err != nil {
+		return storage.NewBatch{}, fmt.Errorf("id_barang %q is not a number", data.IdBarang)
+	}
+	stok, err := strconv.ParseInt(strings.TrimSpace(data.Stok), 10, 32)
+	if err != nil {
+		return storage.NewBatch{}, fmt.Errorf("stok %q is not a number", data.Stok)
+	}
+	for _, layout := range legacyExpiredLayouts {
+		expired, err := time.Parse(layout, strings.TrimSpace(data.ExpDate))
+		if err == nil {
+			return storage.NewBatch{IDBarang: idBarang, Stok: int32(stok), Expired: expired.Format(dateLayout), NoBatch: data.NoBatch}, nil
+		}
+	}
+	return storage.NewBatch{}, fmt.Errorf("exp_date %q is not a YYYY-MM-DD date", data.ExpDate)
 }
 
+// newBatchFromV2 validates a CreateBulkRefV2 and returns an InvalidArgument
+// error naming the offending field. exp_date is stored as its calendar date
+// in loc.
+func newBatchFromV2(i int, data *crud.CreateBulkRefV2, loc *time.Location) (storage.NewBatch, error) {
+	switch {
+	case data.IdBarang <= 0:
+		return storage.NewBatch{}, invalidArgument(fmt.Sprintf("data_v2[%d].id_barang", i), "data_v2[%d].id_barang must be positive", i)
+	case data.Stok < 0:
+		return storage.NewBatch{}, invalidArgument(fmt.Sprintf("data_v2[%d].stok", i), "data_v2[%d].stok must not be negative", i)
+	case data.ExpDate == nil:
+		return storage.NewBatch{}, invalidArgument(fmt.Sprintf("data_v2[%d].exp_date", i), "data_v2[%d].exp_date is required", i)
+	case data.ExpDate.CheckValid() != nil:
+		return storage.NewBatch{}, invalidArgument(fmt.Sprintf("data_v2[%d].exp_date", i), "data_v2[%d].exp_date is not a valid timestamp", i)
+	case data.NoBatch == "":
+		return storage.NewBatch{}, invalidArgument(fmt.Sprintf("data_v2[%d].no_batch", i), "data_v2[%d].no_batch is required", i)
+	case utf8.RuneCountInString(data.NoBatch) > maxNoBatchLen:
+		return storage.NewBatch{}, invalidArgument(fmt.Sprintf("data_v2[%d].no_batch", i), "data_v2[%d].no_batch must be at most %d characters", i, maxNoBatchLen)
+	}
+	return storage.NewBatch{
+		IDBarang: data.IdBarang,
+		Stok:     data.Stok,
+		Expired:  data.ExpDate.AsTime().In(loc).Format(dateLayout),
+		NoBatch:  data.NoBatch,
+	}, nil
+}
+
+// CreateBulkRef inserts all rows in one transaction. By default a single bad
+// row rolls back the whole request; with best_effort the bad rows are skipped
+// and the rest is committed. Either way every failed row is reported.
 func (s *server) CreateBulkRef(ctx context.Context, req *crud.CreateBulkRefRequest) (*crud.CreateBulkRefResponse, error) {
 	startTime := time.Now()
 	cpuStart := getCurrentCPUUsage()
 
-	query := "INSERT INTO `ref_barang` (`id_ref_barang`, `id_barang`, `stok`, `expired`, `no_batch`, `created_date`) VALUES (NULL,?,?,?,?, current_timestamp());"
-
-	tx, err := s.db.Begin()
-	if err != nil {
-		return nil, err
+	var rowErrors []*crud.RowError
+	var batches []storage.NewBatch
+	var indexes []int // request index of each entry of batches
+	for i, data := range req.Data {
+		batch, err := newBatchFromLegacy(data)
+		if err != nil {
+			rowErrors = append(rowErrors, &crud.RowError{Index: int64(i), NoBatch: data.NoBatch, Reason: err.Error()})
+			continue
+		}
+		batches = append(batches, batch)
+		indexes = append(indexes, i)
 	}
-	defer func() {
+	for i, data := range req.DataV2 {
+		batch, err := newBatchFromV2(i, data, s.location)
 		if err != nil {
-
-			tx.Rollback()
-			return
+			rowErrors = append(rowErrors, &crud.RowError{Index: int64(len(req.Data) + i), NoBatch: data.NoBatch, Reason: status.Convert(err).Message()})
+			continue
 		}
-		tx.Commit()
-	}()
-
-	stmt, err := tx.Prepare(query)
-	if err != nil {
-		return nil, err
+		batches = append(batches, batch)
+		indexes = append(indexes, len(req.Data)+i)
 	}
-	defer stmt.Close()
+	total := len(req.Data) + len(req.DataV2)
 
-	for _, data := range req.Data {
-
-		_, err := stmt.Exec(data.IdBarang, data.Stok, data.ExpDate, data.NoBatch)
+	// A row that does not even parse already fails an all-or-nothing request.
+	if len(rowErrors) == 0 || req.BestEffort {
+		failures, err := s.batch.CreateBatches(ctx, batches, req.BestEffort)
 		if err != nil {
 			return nil, err
 		}
-
+		for _, failure := range failures {
+			rowErrors = append(rowErrors, &crud.RowError{Index: int64(indexes[failure.Index]), NoBatch: batches[failure.Index].NoBatch, Reason: rowErrorReason(failure.Err)})
+		}
+	}
+	sort.Slice(rowErrors, func(i, j int) bool { return rowErrors[i].Index < rowErrors[j].Index })
+
+	if len(rowErrors) > 0 && !req.BestEffort {
+		return &crud.CreateBulkRefResponse{
+			Success: false,
+			Message: fmt.Sprintf("Bulk create rolled back, %d of %d rows failed", len(rowErrors), total),
+			Errors:  rowErrors,
+		}, nil
 	}
 
-	cpuEnd := getCurrentCPUUsage()
-
-	duration := time.Since(startTime)
-	cpuUsage := cpuEnd - cpuStart
+	logDuration(startTime, cpuStart)
 
-	// Log atau lakukan sesuatu dengan informasi penggunaan CPU
-	log.Printf("Durasi eksekusi: %v, Penggunaan CPU: %f\n", duration, cpuUsage)
+	message := "Bulk create successful"
+	if len(rowErrors) > 0 {
+		message = fmt.Sprintf("Bulk create finished, %d of %d rows skipped", len(rowErrors), total)
+	}
 	return &crud.CreateBulkRefResponse{
-		Success: true,
-		Message: "Bulk create successful",
+		Success:  true,
+		Message:  message,
+		Inserted: int64(total - len(rowErrors)),
+		Errors:   rowErrors,
 	}, nil
 }
 
 func main() {
+	cfg, err := loadConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		log.Fatalf("Invalid timezone %q: %v", cfg.Timezone, err)
+	}
+	opts := storeOptions{db: cfg.Database, today: time.Now().In(location)}
+
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(cfg.Storage, opts, flag.Args()[1:]); err != nil {
+			log.Fatalf("Migrate failed: %v", err)
+		}
+		return
+	}
+
 	// Enable logging to console
 	log.SetOutput(os.Stdout)
 
+	if flag.Arg(0) == "seed" {
+		if cfg.Storage == storageMemory {
+			log.Fatalf("Seed needs a database, the %s storage is gone when the command exits", storageMemory)
+		}
+		store, closeStore, err := openStore(cfg.Storage, opts)
+		if err != nil {
+			log.Fatalf("Failed to connect to database: %v", err)
+		}
+		defer closeStore()
+		if err := runSeed(store, opts.today, flag.Args()[1:]); err != nil {
+			log.Fatalf("Seed failed: %v", err)
+		}
+		return
+	}
+
 	// Create a Zap logger
-	// logger, _ := zap.NewDevelopment()
-	// zap.ReplaceGlobals(logger)
+	level, _ := zapcore.ParseLevel(cfg.LogLevel)
+	zapConfig := zap.NewProductionConfig()
+	zapConfig.Level = zap.NewAtomicLevelAt(level)
+	logger, err := zapConfig.Build()
+	if err != nil {
+		log.Fatalf("Failed to create logger: %v", err)
+	}
+	zap.ReplaceGlobals(logger)
 
-	listen, err := net.Listen("tcp", port)
+	store, closeStore, err := openStore(cfg.Storage, opts)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	if cfg.DebugListen != "" {
+		if err := serveDebug(cfg.DebugListen); err != nil {
+			log.Fatalf("Failed to listen on debug address: %v", err)
+		}
+		fmt.Printf("Debug variables on http://%s/debug/vars\n", cfg.DebugListen)
+	}
+
+	listen, err := net.Listen("tcp", cfg.Listen)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	fmt.Printf("Server listening on port %s\n", port)
+	fmt.Printf("Server listening on port %s\n", cfg.Listen)
 
-	db, err := sql.Open("mysql", fmt.Sprintf("%s:%s@tcp(%s:%s)/%s", dbUser, dbPass, dbHost, dbPort, dbName))
+	validator, err := protovalidate.New()
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		log.Fatalf("Failed to create request validator: %v", err)
 	}
-	defer db.Close()
 
+	hs := newHealthService()
 	s := grpc.NewServer(
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			grpc_ctxtags.UnaryServerInterceptor(),
 			grpc_zap.UnaryServerInterceptor(zap.L().Named("grpc")),
+			deadlineUnaryInterceptor(cfg.RPCTimeout),
+			errorStatusUnaryInterceptor(),
+			validationUnaryInterceptor(validator),
+		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_ctxtags.StreamServerInterceptor(),
+			grpc_zap.StreamServerInterceptor(zap.L().Named("grpc")),
+			deadlineStreamInterceptor(cfg.StreamTimeout),
+			errorStatusStreamInterceptor(),
+			validationStreamInterceptor(validator),
+			hs.watchStreamInterceptor(),
 		)),
 	)
-	crud.RegisterCrudServiceServer(s, &server{db: db})
+	crud.RegisterCrudServiceServer(s, &server{barang: store, batch: store, importChunkSize: cfg.ImportChunkSize, location: location})
+	crud.RegisterReferensiServiceServer(s, &referensiServer{referensi: store})
+	hs.register(s)
+	go hs.watch(store, cfg.HealthInterval)
 
-	if err := s.Serve(listen); err != nil {
+	if err := serveUntilSignal(s, listen, hs, cfg.ShutdownDelay, cfg.ShutdownTimeout); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 
+	// Only closed once no call uses it any more.
+	if err := closeStore(); err != nil {
+		log.Printf("Failed to close database: %v", err)
+	}
+	log.Println("Server stopped")
 }
@@ -0,0 +1,153 @@
+// server/server_test.go
+package main
+
+import (
+	"context"
+	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
+	"grpc_crud/storage/memstore"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewBatchFromLegacy(t *testing.T) {
+	for _, test := range []struct {
+		data *crud.CreateBulkRef
+		want storage.NewBatch
+	}{
+		{&crud.CreateBulkRef{IdBarang: "7", Stok: "12", ExpDate: "2024-05-10", NoBatch: "B1"}, storage.NewBatch{IDBarang: 7, Stok: 12, Expired: "2024-05-10", NoBatch: "B1"}},
+		{&crud.CreateBulkRef{IdBarang: " 7 ", Stok: " 0 ", ExpDate: " 2024-05-10 ", NoBatch: "B1"}, storage.NewBatch{IDBarang: 7, Stok: 0, Expired: "2024-05-10", NoBatch: "B1"}},
+		{&crud.CreateBulkRef{IdBarang: "7", Stok: "12", ExpDate: "2024-05-10 23:59:59", NoBatch: "B1"}, storage.NewBatch{IDBarang: 7, Stok: 12, Expired: "2024-05-10", NoBatch: "B1"}},
+	} {
+		if got, err := newBatchFromLegacy(test.data); err != nil || got != test.want {
+			t.Errorf("newBatchFromLegacy(%v) = %+v, %v; want %+v", test.data, got, err, test.want)
+		}
+	}
+
+	for _, test := range []struct {
+		data   *crud.CreateBulkRef
+		reason string
+	}{
+		{&crud.CreateBulkRef{IdBarang: "tujuh", Stok: "12", ExpDate: "2024-05-10"}, `id_barang "tujuh" is not a number`},
+		{&crud.CreateBulkRef{IdBarang: "7", Stok: "1.5", ExpDate: "2024-05-10"}, `stok "1.5" is not a number`},
+		{&crud.CreateBulkRef{IdBarang: "7", Stok: "3000000000", ExpDate: "2024-05-10"}, `stok "3000000000" is not a number`},
+		{&crud.CreateBulkRef{IdBarang: "7", Stok: "12", ExpDate: "10/05/2024"}, `exp_date "10/05/2024" is not a YYYY-MM-DD date`},
+		{&crud.CreateBulkRef{IdBarang: "7", Stok: "12", ExpDate: "2024-02-30"}, `exp_date "2024-02-30" is not a YYYY-MM-DD date`},
+		{&crud.CreateBulkRef{IdBarang: "7", Stok: "12", ExpDate: "2024-05-10", NoBatch: strings.Repeat("x", maxNoBatchLen+1)}, "no_batch is longer than 50 characters"},
+	} {
+		if _, err := newBatchFromLegacy(test.data); err == nil || err.Error() != test.reason {
+			t.Errorf("newBatchFromLegacy(%v) = %v, want %q", test.data, err, test.reason)
+		}
+	}
+}
+
+// newBulkServer returns a server on an empty memstore holding one barang.
+func newBulkServer(t *testing.T) (*server, storage.Store, int64) {
+	t.Helper()
+	ctx := context.Background()
+	store := memstore.New()
+	var ids []int64
+	for _, lookup := range []storage.Lookup{storage.Kategori, storage.Jenis, storage.Material} {
+		row, err := store.CreateLookup(ctx, lookup, "x")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, row.ID)
+	}
+	idBarang, err := store.CreateBarang(ctx, storage.NewBarang{NamaBarang: "Teh", Harga: 3000, IDKategori: ids[0], IDJenis: ids[1], IDMaterial: ids[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &server{barang: store, batch: store, location: time.UTC}, store, idBarang
+}
+
+type rowErrorWant struct {
+	index  int64
+	reason string
+}
+
+func checkRowErrors(t *testing.T, got []*crud.RowError, want []rowErrorWant) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d row errors %v, want %v", len(got), got, want)
+	}
+	for i := range want {
+		if got[i].Index != want[i].index || got[i].Reason != want[i].reason {
+			t.Errorf("row error %d = %d %q, want %d %q", i, got[i].Index, got[i].Reason, want[i].index, want[i].reason)
+		}
+	}
+}
+
+func TestCreateBulkRefRowIndexes(t *testing.T) {
+	ctx := context.Background()
+	s, store, idBarang := newBulkServer(t)
+	expired := timestamppb.New(time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC))
+	legacyID := strconv.FormatInt(idBarang, 10)
+
+	req := &crud.CreateBulkRefRequest{
+		BestEffort: true,
+		Data: []*crud.CreateBulkRef{
+			{IdBarang: legacyID, Stok: "1", ExpDate: "2024-05-10", NoBatch: "L0"},
+			{IdBarang: legacyID, Stok: "x", ExpDate: "2024-05-10", NoBatch: "L1"},
+			{IdBarang: "999", Stok: "1", ExpDate: "2024-05-10", NoBatch: "L2"},
+		},
+		DataV2: []*crud.CreateBulkRefV2{
+			{IdBarang: idBarang, Stok: 1, ExpDate: expired, NoBatch: "V0"},
+			{IdBarang: idBarang, Stok: -1, ExpDate: expired, NoBatch: "V1"},
+			{IdBarang: 999, Stok: 1, ExpDate: expired, NoBatch: "V2"},
+		},
+	}
+	resp, err := s.CreateBulkRef(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Success || resp.Inserted != 2 {
+		t.Errorf("best effort: success %v, inserted %d; want true, 2", resp.Success, resp.Inserted)
+	}
+	checkRowErrors(t, resp.Errors, []rowErrorWant{
+		{1, `stok "x" is not a number`},
+		{2, "id_barang 999 does not exist"},
+		{4, "data_v2[1].stok must not be negative"},
+		{5, "id_barang 999 does not exist"},
+	})
+
+	// All-or-nothing reports the rows that do not parse, legacy and v2
+	// alike, and writes nothing.
+	req.BestEffort = false
+	resp, err = s.CreateBulkRef(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Success || resp.Inserted != 0 {
+		t.Errorf("all-or-nothing: success %v, inserted %d; want false, 0", resp.Success, resp.Inserted)
+	}
+	checkRowErrors(t, resp.Errors, []rowErrorWant{
+		{1, `stok "x" is not a number`},
+		{4, "data_v2[1].stok must not be negative"},
+	})
+
+	// Once every row parses, the rows the store rejects are reported and
+	// the rest rolled back.
+	req.Data = req.Data[2:]
+	req.DataV2 = req.DataV2[:1]
+	resp, err = s.CreateBulkRef(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Success || resp.Inserted != 0 {
+		t.Errorf("all-or-nothing: success %v, inserted %d; want false, 0", resp.Success, resp.Inserted)
+	}
+	checkRowErrors(t, resp.Errors, []rowErrorWant{{0, "id_barang 999 does not exist"}})
+
+	rows, total, err := store.ListBatches(ctx, storage.Page{Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 || rows[0].NoBatch != "L0" || rows[1].NoBatch != "V0" {
+		t.Errorf("stored lots = %+v, want only L0 and V0 of the best effort request", rows)
+	}
+}
@@ -0,0 +1,66 @@
+// server/shutdown.go
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// serveUntilSignal serves s on listen until SIGINT or SIGTERM arrives. It
+// then reports NOT_SERVING on hs, keeps serving for delay so that load
+// balancers stop routing new calls here, and stops s gracefully, see
+// gracefulStop. It returns the error of Serve if the server fails before
+// that.
+func serveUntilSignal(s *grpc.Server, listen net.Listener, hs *healthService, delay, timeout time.Duration) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- s.Serve(listen) }()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+	// From here on a second signal terminates the process right away.
+	stop()
+
+	hs.Shutdown()
+	if delay > 0 {
+		log.Printf("Reporting NOT_SERVING, stopping in %v", delay)
+		time.Sleep(delay)
+	}
+	hs.endWatches()
+	log.Printf("Shutting down, waiting up to %v for running calls", timeout)
+	gracefulStop(s, timeout)
+	return nil
+}
+
+// gracefulStop stops accepting connections and waits for the running calls
+// to finish. Calls still running after timeout are cancelled; their
+// transactions roll back because every query runs on the call's context.
+func gracefulStop(s *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+	case <-timer.C:
+		log.Printf("Shutdown timeout of %v reached, cancelling the remaining calls", timeout)
+		s.Stop()
+		<-done
+	}
+}
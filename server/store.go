@@ -0,0 +1,192 @@
+// server/store.go
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"grpc_crud/storage"
+	"grpc_crud/storage/memstore"
+	"grpc_crud/storage/sqlstore"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+)
+
+// Storage backends accepted by the -storage flag.
+const (
+	storageMySQL    = "mysql"
+	storagePostgres = "postgres"
+	storageSQLite   = "sqlite"
+	storageMemory   = "memory"
+)
+
+// storeOptions are the settings openStore needs besides the backend name.
+type storeOptions struct {
+	db    dbConfig
+	today time.Time
+}
+
+// isPostgresDSN reports whether dsn is a PostgreSQL URL or a libpq
+// key=value string such as "host=localhost dbname=db_skripsi_2". Such a DSN
+// selects the postgres backend even when -storage is left at mysql. A MySQL
+// DSN is never taken for key=value: before its first '=' it holds at least
+// the '/' in front of the database name.
+func isPostgresDSN(dsn string) bool {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		return true
+	}
+	key, _, ok := strings.Cut(strings.TrimSpace(dsn), "=")
+	return ok && key != "" && strings.TrimLeft(key, "abcdefghijklmnopqrstuvwxyz_") == ""
+}
+
+// mysqlDSN builds the MySQL DSN from the separate database settings.
+func mysqlDSN(db dbConfig) string {
+	port := db.Port
+	if port == 0 {
+		port = 3306
+	}
+	cfg := mysql.NewConfig()
+	cfg.User = db.User
+	cfg.Passwd = db.Password
+	cfg.Net = "tcp"
+	cfg.Addr = net.JoinHostPort(db.Host, strconv.Itoa(port))
+	cfg.DBName = db.Name
+	cfg.Timeout = db.ConnectTimeout
+	cfg.ReadTimeout = db.ReadTimeout
+	cfg.WriteTimeout = db.WriteTimeout
+	return cfg.FormatDSN()
+}
+
+// postgresDSN builds the PostgreSQL URL from the separate database settings.
+func postgresDSN(db dbConfig) string {
+	port := db.Port
+	if port == 0 {
+		port = 5432
+	}
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(db.User, db.Password),
+		Host:   net.JoinHostPort(db.Host, strconv.Itoa(port)),
+		Path:   "/" + db.Name,
+	}
+	if db.ConnectTimeout > 0 {
+		// connect_timeout is in whole seconds; round up so that a
+		// sub-second timeout does not become 0, which means none.
+		seconds := (db.ConnectTimeout + time.Second - 1) / time.Second
+		u.RawQuery = url.Values{"connect_timeout": {strconv.Itoa(int(seconds))}}.Encode()
+	}
+	return u.String()
+}
+
+// openDB opens the database of one of the SQL backends and applies the pool
+// settings.
+func openDB(backend string, opts storeOptions) (*sql.DB, sqlstore.Dialect, error) {
+	if backend == storageMySQL && isPostgresDSN(opts.db.DSN) {
+		backend = storagePostgres
+	}
+
+	var db *sql.DB
+	var dialect sqlstore.Dialect
+	var err error
+	switch backend {
+	case storageMySQL:
+		dsn := opts.db.DSN
+		if dsn == "" {
+			dsn = mysqlDSN(opts.db)
+		}
+		db, err = sql.Open("mysql", dsn)
+		dialect = sqlstore.MySQL
+	case storagePostgres:
+		dsn := opts.db.DSN
+		if dsn == "" {
+			dsn = postgresDSN(opts.db)
+		}
+		db, err = sql.Open("pgx", dsn)
+		dialect = sqlstore.PostgreSQL
+	case storageSQLite:
+		db, err = sql.Open("sqlite", sqlstore.SQLiteDSN(opts.db.SQLitePath))
+		dialect = sqlstore.SQLite
+	case storageMemory:
+		return nil, sqlstore.Dialect{}, fmt.Errorf("storage %s has no database", storageMemory)
+	default:
+		return nil, sqlstore.Dialect{}, fmt.Errorf("unknown storage %q, want %s, %s, %s or %s", backend, storageMySQL, storagePostgres, storageSQLite, storageMemory)
+	}
+	if err != nil {
+		return nil, sqlstore.Dialect{}, err
+	}
+	db.SetMaxOpenConns(opts.db.MaxOpenConns)
+	db.SetMaxIdleConns(opts.db.MaxIdleConns)
+	db.SetConnMaxLifetime(opts.db.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(opts.db.ConnMaxIdleTime)
+
+	if err := pingDB(db, opts.db); err != nil {
+		db.Close()
+		return nil, sqlstore.Dialect{}, err
+	}
+	return db, dialect, nil
+}
+
+// maxPingBackoff caps the wait between two startup pings.
+const maxPingBackoff = 30 * time.Second
+
+// pingDB waits for the database to accept connections. sql.Open does not
+// connect, so without it a server whose database is down would only notice
+// on the first request.
+func pingDB(db *sql.DB, cfg dbConfig) error {
+	backoff := cfg.PingBackoff
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.Background(), func() {}
+		if cfg.ConnectTimeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, cfg.ConnectTimeout)
+		}
+		err := db.PingContext(ctx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if attempt >= cfg.PingAttempts {
+			return fmt.Errorf("database not reachable after %d attempts: %w", attempt, err)
+		}
+
+		log.Printf("Database not reachable (attempt %d/%d), retrying in %v: %v", attempt, cfg.PingAttempts, backoff, err)
+		time.Sleep(backoff)
+		backoff = min(2*backoff, maxPingBackoff)
+	}
+}
+
+// openStore returns the storage backend and a function that releases it.
+// A SQLite file is migrated to the latest schema on open, so a new one is
+// ready to use; MySQL and PostgreSQL are migrated with the migrate command.
+// The memory backend starts with sample data whose expiry dates are relative
+// to today, so it needs nothing besides the server binary.
+func openStore(backend string, opts storeOptions) (storage.Store, func() error, error) {
+	if backend == storageMemory {
+		store := memstore.New()
+		store.Seed(opts.today)
+		return store, func() error { return nil }, nil
+	}
+
+	db, dialect, err := openDB(backend, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if dialect == sqlstore.SQLite {
+		if _, err := sqlstore.MigrateUp(context.Background(), db, dialect); err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+	}
+	publishDBStats(db)
+	if opts.db.StatsInterval > 0 {
+		go logDBStats(db, opts.db.StatsInterval)
+	}
+	return sqlstore.New(db, dialect), db.Close, nil
+}
@@ -0,0 +1,86 @@
+// server/stream.go
+package main
+
+import (
+	"grpc_crud/proto/crud"
+	"grpc_crud/storage"
+	"log"
+	"time"
+)
+
+// The Stream* handlers send each row as soon as the repository yields it
+// instead of collecting a slice first. The query runs on the stream context,
+// so a client that disconnects or cancels also cancels the database query.
+
+func (s *server) StreamAll(req *crud.StreamAllRequest, stream crud.CrudService_StreamAllServer) error {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	var sent int
+	err := s.batch.EachItem(stream.Context(), func(row storage.Batch) error {
+		if err := stream.Send(responseRead(row)); err != nil {
+			return err
+		}
+		sent++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Jumlah baris terkirim: %d", sent)
+	logDuration(startTime, cpuStart)
+	return nil
+}
+
+func (s *server) StreamExpiredBarang(req *crud.StreamExpiredBarangRequest, stream crud.CrudService_StreamExpiredBarangServer) error {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	asOf, err := s.businessDate(req.AsOf)
+	if err != nil {
+		return err
+	}
+
+	var sent int
+	err = s.batch.EachExpired(stream.Context(), asOf.Format(dateLayout), func(row storage.Batch) error {
+		if err := stream.Send(responseReadExpired(row)); err != nil {
+			return err
+		}
+		sent++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Jumlah baris terkirim: %d", sent)
+	logDuration(startTime, cpuStart)
+	return nil
+}
+
+func (s *server) StreamNotExpiredBarang(req *crud.StreamNotExpiredBarangRequest, stream crud.CrudService_StreamNotExpiredBarangServer) error {
+	startTime := time.Now()
+	cpuStart := getCurrentCPUUsage()
+
+	asOf, err := s.businessDate(req.AsOf)
+	if err != nil {
+		return err
+	}
+
+	var sent int
+	err = s.batch.EachNotExpired(stream.Context(), asOf.Format(dateLayout), func(row storage.Batch) error {
+		if err := stream.Send(responseReadNotExpired(row)); err != nil {
+			return err
+		}
+		sent++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Jumlah baris terkirim: %d", sent)
+	logDuration(startTime, cpuStart)
+	return nil
+}
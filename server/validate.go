@@ -0,0 +1,79 @@
+// server/validate.go
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/protovalidate-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// validationUnaryInterceptor checks every request against the
+// buf.validate constraints declared in proto/service.proto before the
+// handler runs.
+func validationUnaryInterceptor(validator *protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validateMessage(validator, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// validationStreamInterceptor checks every message received on a stream.
+func validationStreamInterceptor(validator *protovalidate.Validator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss, validator: validator})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	validator *protovalidate.Validator
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateMessage(s.validator, m)
+}
+
+// validateMessage returns an InvalidArgument status with one
+// google.rpc.BadRequest field violation per broken constraint.
+func validateMessage(validator *protovalidate.Validator, m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	err := validator.Validate(msg)
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	var messages []string
+	for _, v := range validationErr.Violations {
+		field := v.FieldPath
+		if field == "" {
+			// Message constraints have ids of the form <field>.<rule>,
+			// after the field they check.
+			field, _, _ = strings.Cut(v.ConstraintId, ".")
+		}
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: v.Message})
+		messages = append(messages, fmt.Sprintf("%s: %s", field, v.Message))
+	}
+	st := status.New(codes.InvalidArgument, strings.Join(messages, "; "))
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
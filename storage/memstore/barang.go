@@ -0,0 +1,145 @@
+// storage/memstore/barang.go
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"grpc_crud/storage"
+)
+
+var allLookups = []storage.Lookup{storage.Kategori, storage.Jenis, storage.Material}
+
+func (s *Store) checkReference(lookup storage.Lookup, id int64) error {
+	if _, ok := s.lookups[lookup].names[id]; !ok {
+		return &storage.ReferenceError{Column: idColumn(lookup), ID: id}
+	}
+	return nil
+}
+
+func (s *Store) CreateBarang(ctx context.Context, barang storage.NewBarang) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ref := range []struct {
+		lookup storage.Lookup
+		id     int64
+	}{
+		{storage.Kategori, barang.IDKategori},
+		{storage.Jenis, barang.IDJenis},
+		{storage.Material, barang.IDMaterial},
+	} {
+		if err := s.checkReference(ref.lookup, ref.id); err != nil {
+			return 0, err
+		}
+	}
+
+	s.nextIDBarang++
+	s.barang[s.nextIDBarang] = barang
+	return s.nextIDBarang, nil
+}
+
+func (s *Store) GetBarang(ctx context.Context, idBarang int64) (storage.Barang, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	barang, ok := s.join(idBarang, allLookups...)
+	if !ok {
+		return storage.Barang{}, fmt.Errorf("barang %d %w", idBarang, storage.ErrNotFound)
+	}
+	return barang, nil
+}
+
+func (s *Store) UpdateBarang(ctx context.Context, idBarang int64, update storage.BarangUpdate) (storage.Barang, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.join(idBarang, allLookups...); !ok {
+		return storage.Barang{}, fmt.Errorf("barang %d %w", idBarang, storage.ErrNotFound)
+	}
+
+	// Changes go to a copy so that a bad reference leaves the row untouched.
+	row := s.barang[idBarang]
+	if update.NamaBarang != nil {
+		row.NamaBarang = *update.NamaBarang
+	}
+	if update.FotoBarang != nil {
+		row.FotoBarang = *update.FotoBarang
+	}
+	if update.Harga != nil {
+		row.Harga = *update.Harga
+	}
+	for _, ref := range []struct {
+		lookup storage.Lookup
+		id     *int64
+		column *int64
+	}{
+		{storage.Kategori, update.IDKategori, &row.IDKategori},
+		{storage.Jenis, update.IDJenis, &row.IDJenis},
+		{storage.Material, update.IDMaterial, &row.IDMaterial},
+	} {
+		if ref.id == nil {
+			continue
+		}
+		if err := s.checkReference(ref.lookup, *ref.id); err != nil {
+			return storage.Barang{}, err
+		}
+		*ref.column = *ref.id
+	}
+	s.barang[idBarang] = row
+
+	barang, _ := s.join(idBarang, allLookups...)
+	return barang, nil
+}
+
+func (s *Store) DeleteBarang(ctx context.Context, idBarang int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, row := range s.refBarang {
+		if row.IDBarang == idBarang {
+			return fmt.Errorf("barang %d %w by ref_barang", idBarang, storage.ErrReferenced)
+		}
+	}
+	if _, ok := s.barang[idBarang]; !ok {
+		return fmt.Errorf("barang %d %w", idBarang, storage.ErrNotFound)
+	}
+	delete(s.barang, idBarang)
+	return nil
+}
+
+func (s *Store) ListBarangByLookup(ctx context.Context, lookup storage.Lookup, page storage.Page) ([]storage.Barang, int32, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var rows []storage.Barang
+	for _, id := range sortedIDs(s.barang) {
+		if barang, ok := s.join(id, lookup); ok {
+			rows = append(rows, barang)
+		}
+	}
+	result := limit(rows, page, func(row storage.Barang, after storage.Cursor) bool {
+		return row.IDBarang > after.ID
+	})
+	return result, int32(len(rows)), nil
+}
+
+func (s *Store) UpdateHargaByBatch(ctx context.Context, noBatch string, harga int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := false
+	for _, row := range s.refBarang {
+		if row.NoBatch != noBatch {
+			continue
+		}
+		found = true
+		if barang, ok := s.barang[row.IDBarang]; ok {
+			barang.Harga = harga
+			s.barang[row.IDBarang] = barang
+		}
+	}
+	if !found {
+		return fmt.Errorf("no_batch %q %w", noBatch, storage.ErrNotFound)
+	}
+	return nil
+}
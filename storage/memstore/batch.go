@@ -0,0 +1,280 @@
+// storage/memstore/batch.go
+package memstore
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"grpc_crud/storage"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// matchItem applies an ItemFilter. The LIKE conditions of the MySQL query
+// compare case-insensitively under the default collation, and so does this.
+func matchItem(filter storage.ItemFilter, row storage.Batch) bool {
+	switch {
+	case filter.IDKategori != 0 && row.IDKategori != filter.IDKategori:
+		return false
+	case filter.IDJenis != 0 && row.IDJenis != filter.IDJenis:
+		return false
+	case filter.IDMaterial != 0 && row.IDMaterial != filter.IDMaterial:
+		return false
+	case filter.HargaMin != nil && row.Harga < *filter.HargaMin:
+		return false
+	case filter.HargaMax != nil && row.Harga > *filter.HargaMax:
+		return false
+	case !strings.Contains(strings.ToLower(row.NamaBarang), strings.ToLower(filter.NamaContains)):
+		return false
+	case !strings.HasPrefix(strings.ToLower(row.NoBatch), strings.ToLower(filter.BatchPrefix)):
+		return false
+	}
+	return true
+}
+
+// compareItems orders two lots by the sort field, then by IDRefBarang.
+// Strings compare case-insensitively, like under the MySQL default collation.
+func compareItems(order storage.ItemSort, a, b storage.Batch) int {
+	var c int
+	switch order.Field {
+	case storage.SortByHarga:
+		c = cmp.Compare(a.Harga, b.Harga)
+	case storage.SortByNamaBarang:
+		c = strings.Compare(strings.ToLower(a.NamaBarang), strings.ToLower(b.NamaBarang))
+	case storage.SortByNoBatch:
+		c = strings.Compare(strings.ToLower(a.NoBatch), strings.ToLower(b.NoBatch))
+	}
+	if c == 0 {
+		c = cmp.Compare(a.IDRefBarang, b.IDRefBarang)
+	}
+	if order.Desc {
+		return -c
+	}
+	return c
+}
+
+// cursorItem turns a cursor back into a row that compareItems can place.
+func cursorItem(order storage.ItemSort, after storage.Cursor) (storage.Batch, error) {
+	row := storage.Batch{IDRefBarang: after.ID}
+	switch order.Field {
+	case storage.SortByHarga:
+		harga, err := strconv.Atoi(after.Value)
+		if err != nil {
+			return storage.Batch{}, fmt.Errorf("invalid harga cursor %q: %w", after.Value, err)
+		}
+		row.Harga = int32(harga)
+	case storage.SortByNamaBarang:
+		row.NamaBarang = after.Value
+	case storage.SortByNoBatch:
+		row.NoBatch = after.Value
+	}
+	return row, nil
+}
+
+func (s *Store) ListItems(ctx context.Context, filter storage.ItemFilter, order storage.ItemSort, page storage.Page) ([]storage.Batch, int32, error) {
+	var pivot storage.Batch
+	if page.After != nil {
+		var err error
+		if pivot, err = cursorItem(order, *page.After); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rows := s.batches(func(row storage.Batch) bool { return matchItem(filter, row) }, allLookups...)
+	slices.SortFunc(rows, func(a, b storage.Batch) int { return compareItems(order, a, b) })
+
+	result := limit(rows, page, func(row storage.Batch, _ storage.Cursor) bool {
+		return compareItems(order, row, pivot) > 0
+	})
+	return result, int32(len(rows)), nil
+}
+
+func (s *Store) EachItem(ctx context.Context, fn func(storage.Batch) error) error {
+	s.mu.RLock()
+	rows := s.batches(func(storage.Batch) bool { return true }, allLookups...)
+	s.mu.RUnlock()
+
+	return each(ctx, rows, fn)
+}
+
+func (s *Store) ListBatches(ctx context.Context, page storage.Page) ([]storage.Batch, int32, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rows := s.batches(func(storage.Batch) bool { return true })
+	return limit(rows, page, batchAfter), int32(len(rows)), nil
+}
+
+// Dates are YYYY-MM-DD, so they compare correctly as strings.
+
+func expiredBefore(asOf string) func(storage.Batch) bool {
+	return func(row storage.Batch) bool { return row.Expired < asOf }
+}
+
+func notExpiredOn(asOf string) func(storage.Batch) bool {
+	return func(row storage.Batch) bool { return row.Expired >= asOf }
+}
+
+func (s *Store) ListExpired(ctx context.Context, asOf string, page storage.Page) ([]storage.Batch, int32, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rows := s.batches(expiredBefore(asOf))
+	return limit(rows, page, batchAfter), int32(len(rows)), nil
+}
+
+func (s *Store) ListNotExpired(ctx context.Context, asOf string, page storage.Page) ([]storage.Batch, int32, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rows := s.batches(notExpiredOn(asOf))
+	return limit(rows, page, batchAfter), int32(len(rows)), nil
+}
+
+func (s *Store) EachExpired(ctx context.Context, asOf string, fn func(storage.Batch) error) error {
+	s.mu.RLock()
+	rows := s.batches(expiredBefore(asOf))
+	s.mu.RUnlock()
+
+	return each(ctx, rows, fn)
+}
+
+func (s *Store) EachNotExpired(ctx context.Context, asOf string, fn func(storage.Batch) error) error {
+	s.mu.RLock()
+	rows := s.batches(notExpiredOn(asOf))
+	s.mu.RUnlock()
+
+	return each(ctx, rows, fn)
+}
+
+func (s *Store) ListExpiring(ctx context.Context, query storage.ExpiringQuery, page storage.Page) ([]storage.Batch, int32, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rows := s.batches(func(row storage.Batch) bool {
+		return row.Expired >= query.Start && row.Expired <= query.End &&
+			(query.IDKategori == 0 || row.IDKategori == query.IDKategori)
+	}, storage.Kategori)
+	// rows are ordered by IDRefBarang already, a stable sort keeps that as
+	// the tie-break.
+	slices.SortStableFunc(rows, func(a, b storage.Batch) int { return strings.Compare(a.Expired, b.Expired) })
+
+	result := limit(rows, page, func(row storage.Batch, after storage.Cursor) bool {
+		return row.Expired > after.Value || (row.Expired == after.Value && row.IDRefBarang > after.ID)
+	})
+	return result, int32(len(rows)), nil
+}
+
+// resolveBatch turns a BatchKey into an id_ref_barang.
+func (s *Store) resolveBatch(key storage.BatchKey) (int64, error) {
+	if key.NoBatch == "" {
+		return key.IDRefBarang, nil
+	}
+
+	var ids []int64
+	for _, id := range sortedIDs(s.refBarang) {
+		if s.refBarang[id].NoBatch == key.NoBatch {
+			ids = append(ids, id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("batch %q %w", key.NoBatch, storage.ErrNotFound)
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, fmt.Errorf("no_batch %q %w, use id_ref_barang", key.NoBatch, storage.ErrAmbiguous)
+	}
+}
+
+func (s *Store) getBatch(idRefBarang int64) (storage.Batch, error) {
+	batch, ok := s.joinBatch(idRefBarang)
+	if !ok {
+		return storage.Batch{}, fmt.Errorf("batch %d %w", idRefBarang, storage.ErrNotFound)
+	}
+	return batch, nil
+}
+
+func (s *Store) GetBatch(ctx context.Context, key storage.BatchKey) (storage.Batch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	idRefBarang, err := s.resolveBatch(key)
+	if err != nil {
+		return storage.Batch{}, err
+	}
+	return s.getBatch(idRefBarang)
+}
+
+func (s *Store) UpdateBatch(ctx context.Context, key storage.BatchKey, update storage.BatchUpdate) (storage.Batch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idRefBarang, err := s.resolveBatch(key)
+	if err != nil {
+		return storage.Batch{}, err
+	}
+	if _, err := s.getBatch(idRefBarang); err != nil {
+		return storage.Batch{}, err
+	}
+
+	row := s.refBarang[idRefBarang]
+	if update.Stok != nil {
+		row.Stok = *update.Stok
+	}
+	if update.Expired != nil {
+		row.Expired = *update.Expired
+	}
+	s.refBarang[idRefBarang] = row
+
+	return s.getBatch(idRefBarang)
+}
+
+func (s *Store) DeleteBatch(ctx context.Context, key storage.BatchKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idRefBarang, err := s.resolveBatch(key)
+	if err != nil {
+		return err
+	}
+	if _, ok := s.refBarang[idRefBarang]; !ok {
+		return fmt.Errorf("batch %d %w", idRefBarang, storage.ErrNotFound)
+	}
+	delete(s.refBarang, idRefBarang)
+	return nil
+}
+
+// CreateBatches checks the barang of every lot itself, in place of the
+// foreign key on ref_barang.id_barang.
+func (s *Store) CreateBatches(ctx context.Context, batches []storage.NewBatch, bestEffort bool) ([]storage.BatchFailure, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var failures []storage.BatchFailure
+	var accepted []storage.NewBatch
+	for i, batch := range batches {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if _, ok := s.barang[batch.IDBarang]; !ok {
+			failures = append(failures, storage.BatchFailure{Index: i, Err: &storage.ReferenceError{Column: "id_barang", ID: batch.IDBarang}})
+			continue
+		}
+		accepted = append(accepted, batch)
+	}
+
+	if len(failures) > 0 && !bestEffort {
+		return failures, nil
+	}
+	for _, batch := range accepted {
+		s.nextIDRefBarang++
+		s.refBarang[s.nextIDRefBarang] = batch
+	}
+	return failures, nil
+}
@@ -0,0 +1,176 @@
+// storage/memstore/memstore.go
+
+// Package memstore implements the storage repositories in memory with the
+// same join semantics as the MySQL schema, so the server can run without any
+// database, e.g. in CI or for frontend development.
+package memstore
+
+import (
+	"context"
+	"grpc_crud/storage"
+	"sort"
+	"sync"
+)
+
+// lookupTable is one of the kategori, jenis and material tables.
+type lookupTable struct {
+	names  map[int64]string
+	nextID int64
+}
+
+// Store implements storage.Store. The zero value is not usable, call New.
+type Store struct {
+	mu              sync.RWMutex
+	lookups         map[storage.Lookup]*lookupTable
+	barang          map[int64]storage.NewBarang
+	refBarang       map[int64]storage.NewBatch
+	nextIDBarang    int64
+	nextIDRefBarang int64
+}
+
+var _ storage.Store = (*Store)(nil)
+
+// New returns an empty Store.
+func New() *Store {
+	return &Store{
+		lookups: map[storage.Lookup]*lookupTable{
+			storage.Kategori: {names: map[int64]string{}},
+			storage.Jenis:    {names: map[int64]string{}},
+			storage.Material: {names: map[int64]string{}},
+		},
+		barang:    map[int64]storage.NewBarang{},
+		refBarang: map[int64]storage.NewBatch{},
+	}
+}
+
+// Ping always succeeds; the store lives as long as the process.
+func (s *Store) Ping(ctx context.Context) error {
+	return nil
+}
+
+// sortedIDs returns the keys of m in ascending order, the order of an
+// auto-increment primary key.
+func sortedIDs[V any](m map[int64]V) []int64 {
+	ids := make([]int64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// idColumn names the foreign key column of barang pointing to lookup.
+func idColumn(lookup storage.Lookup) string {
+	return "id_" + lookup.String()
+}
+
+// lookupID returns the foreign key of row pointing to lookup.
+func lookupID(row storage.NewBarang, lookup storage.Lookup) int64 {
+	switch lookup {
+	case storage.Kategori:
+		return row.IDKategori
+	case storage.Jenis:
+		return row.IDJenis
+	}
+	return row.IDMaterial
+}
+
+// join returns the barang with the names of the given lookups filled in. Like
+// an INNER JOIN it reports false when the barang or one of those lookup rows
+// does not exist.
+func (s *Store) join(idBarang int64, lookups ...storage.Lookup) (storage.Barang, bool) {
+	row, ok := s.barang[idBarang]
+	if !ok {
+		return storage.Barang{}, false
+	}
+	barang := storage.Barang{
+		IDBarang:   idBarang,
+		NamaBarang: row.NamaBarang,
+		FotoBarang: row.FotoBarang,
+		Harga:      row.Harga,
+		IDKategori: row.IDKategori,
+		IDJenis:    row.IDJenis,
+		IDMaterial: row.IDMaterial,
+	}
+	for _, lookup := range lookups {
+		nama, ok := s.lookups[lookup].names[lookupID(row, lookup)]
+		if !ok {
+			return storage.Barang{}, false
+		}
+		switch lookup {
+		case storage.Kategori:
+			barang.NamaKategori = nama
+		case storage.Jenis:
+			barang.NamaJenis = nama
+		case storage.Material:
+			barang.NamaMaterial = nama
+		}
+	}
+	return barang, true
+}
+
+// joinBatch returns the lot joined with its barang and the given lookups.
+func (s *Store) joinBatch(idRefBarang int64, lookups ...storage.Lookup) (storage.Batch, bool) {
+	row, ok := s.refBarang[idRefBarang]
+	if !ok {
+		return storage.Batch{}, false
+	}
+	barang, ok := s.join(row.IDBarang, lookups...)
+	if !ok {
+		return storage.Batch{}, false
+	}
+	return storage.Batch{
+		IDRefBarang: idRefBarang,
+		NoBatch:     row.NoBatch,
+		Stok:        row.Stok,
+		Expired:     row.Expired,
+		Barang:      barang,
+	}, true
+}
+
+// batches returns every lot that survives the joins and keep, ordered by
+// IDRefBarang.
+func (s *Store) batches(keep func(storage.Batch) bool, lookups ...storage.Lookup) []storage.Batch {
+	var result []storage.Batch
+	for _, id := range sortedIDs(s.refBarang) {
+		batch, ok := s.joinBatch(id, lookups...)
+		if ok && keep(batch) {
+			result = append(result, batch)
+		}
+	}
+	return result
+}
+
+// limit keeps the rows after page.After, at most page.Limit of them. rows
+// must already be in the order that after compares by.
+func limit[T any](rows []T, page storage.Page, after func(T, storage.Cursor) bool) []T {
+	var result []T
+	for _, row := range rows {
+		if page.After != nil && !after(row, *page.After) {
+			continue
+		}
+		if len(result) == page.Limit {
+			break
+		}
+		result = append(result, row)
+	}
+	return result
+}
+
+// each hands the rows to fn one by one, stopping early when ctx is done. It
+// is called without holding the lock, since fn may block on a slow client.
+func each[T any](ctx context.Context, rows []T, fn func(T) error) error {
+	for _, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func batchAfter(row storage.Batch, after storage.Cursor) bool {
+	return row.IDRefBarang > after.ID
+}
@@ -0,0 +1,12 @@
+// storage/memstore/memstore_test.go
+package memstore
+
+import (
+	"grpc_crud/storage"
+	"grpc_crud/storage/storagetest"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storage.Store { return New() })
+}
@@ -0,0 +1,64 @@
+// storage/memstore/referensi.go
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"grpc_crud/storage"
+)
+
+func (s *Store) ListLookup(ctx context.Context, lookup storage.Lookup) ([]storage.LookupRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t := s.lookups[lookup]
+	var result []storage.LookupRow
+	for _, id := range sortedIDs(t.names) {
+		result = append(result, storage.LookupRow{ID: id, Nama: t.names[id]})
+	}
+	return result, nil
+}
+
+func (s *Store) CreateLookup(ctx context.Context, lookup storage.Lookup, nama string) (storage.LookupRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.lookups[lookup]
+	t.nextID++
+	t.names[t.nextID] = nama
+	return storage.LookupRow{ID: t.nextID, Nama: nama}, nil
+}
+
+func (s *Store) RenameLookup(ctx context.Context, lookup storage.Lookup, id int64, nama string) (storage.LookupRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.lookups[lookup]
+	if _, ok := t.names[id]; !ok {
+		return storage.LookupRow{}, fmt.Errorf("%s %d %w", lookup, id, storage.ErrNotFound)
+	}
+	t.names[id] = nama
+	return storage.LookupRow{ID: id, Nama: nama}, nil
+}
+
+func (s *Store) DeleteLookup(ctx context.Context, lookup storage.Lookup, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var used int
+	for _, row := range s.barang {
+		if lookupID(row, lookup) == id {
+			used++
+		}
+	}
+	if used > 0 {
+		return fmt.Errorf("%s %d %w by %d barang", lookup, id, storage.ErrReferenced, used)
+	}
+
+	t := s.lookups[lookup]
+	if _, ok := t.names[id]; !ok {
+		return fmt.Errorf("%s %d %w", lookup, id, storage.ErrNotFound)
+	}
+	delete(t.names, id)
+	return nil
+}
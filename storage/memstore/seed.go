@@ -0,0 +1,67 @@
+// storage/memstore/seed.go
+package memstore
+
+import (
+	"grpc_crud/storage"
+	"time"
+)
+
+// Seed fills the store with a small shop inventory. Expiry dates are offsets
+// from today so that the expired, not expired and expiring listings always
+// have something to show: one lot expired a month ago, one yesterday, one
+// expires today (and is still good) and the rest in the coming days. Seed
+// expects an empty store, the references below use fixed ids.
+func (s *Store) Seed(today time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for lookup, names := range map[storage.Lookup][]string{
+		storage.Kategori: {"Makanan", "Minuman", "Perawatan Diri"},
+		storage.Jenis:    {"Kemasan", "Botol", "Sachet"},
+		storage.Material: {"Plastik", "Kaca", "Kertas"},
+	} {
+		t := s.lookups[lookup]
+		for _, nama := range names {
+			t.nextID++
+			t.names[t.nextID] = nama
+		}
+	}
+
+	for _, barang := range []storage.NewBarang{
+		{NamaBarang: "Biskuit Kelapa", FotoBarang: "biskuit-kelapa.jpg", Harga: 8500, IDKategori: 1, IDJenis: 1, IDMaterial: 3},
+		{NamaBarang: "Teh Melati", FotoBarang: "teh-melati.jpg", Harga: 4000, IDKategori: 2, IDJenis: 2, IDMaterial: 1},
+		{NamaBarang: "Kecap Manis", FotoBarang: "kecap-manis.jpg", Harga: 12500, IDKategori: 1, IDJenis: 2, IDMaterial: 2},
+		{NamaBarang: "Sampo Lidah Buaya", FotoBarang: "sampo-lidah-buaya.jpg", Harga: 1000, IDKategori: 3, IDJenis: 3, IDMaterial: 1},
+		{NamaBarang: "Kopi Susu", FotoBarang: "kopi-susu.jpg", Harga: 1500, IDKategori: 2, IDJenis: 3, IDMaterial: 1},
+		// No lots yet, so it shows up in GetBarang but not in ReadAll.
+		{NamaBarang: "Sabun Cair", FotoBarang: "sabun-cair.jpg", Harga: 23000, IDKategori: 3, IDJenis: 2, IDMaterial: 1},
+	} {
+		s.nextIDBarang++
+		s.barang[s.nextIDBarang] = barang
+	}
+
+	for _, lot := range []struct {
+		idBarang int64
+		noBatch  string
+		stok     int32
+		days     int
+	}{
+		{1, "BSK-2401", 40, -30},
+		{1, "BSK-2402", 60, 5},
+		{2, "TEH-2401", 120, 0},
+		{2, "TEH-2402", 80, 90},
+		{3, "KCP-2401", 25, -1},
+		{3, "KCP-2402", 50, 365},
+		{4, "SMP-2401", 200, 14},
+		{5, "KOP-2401", 300, 3},
+		{5, "KOP-2402", 150, 30},
+	} {
+		s.nextIDRefBarang++
+		s.refBarang[s.nextIDRefBarang] = storage.NewBatch{
+			IDBarang: lot.idBarang,
+			Stok:     lot.stok,
+			Expired:  today.AddDate(0, 0, lot.days).Format("2006-01-02"),
+			NoBatch:  lot.noBatch,
+		}
+	}
+}
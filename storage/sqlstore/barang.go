@@ -0,0 +1,236 @@
+// storage/sqlstore/barang.go
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"grpc_crud/storage"
+	"strings"
+)
+
+// selectBarang uses the same joins as ReadAll, minus ref_barang, so a barang
+// without any batch can still be fetched.
+const selectBarang = "SELECT b.id_barang, b.nama_barang, b.foto_barang, b.harga, k.id_kategori, k.nama_kategori, j.id_jenis, j.nama_jenis, m.id_material, m.nama_material FROM barang b INNER JOIN kategori k ON b.id_kategori = k.id_kategori INNER JOIN material m ON b.id_material = m.id_material INNER JOIN jenis j ON b.id_jenis = j.id_jenis"
+
+// reference is a lookup row a barang write points to.
+type reference struct {
+	lookup storage.Lookup
+	id     int64
+}
+
+// checkReference looks the lookup row up, so that a missing row is reported
+// by column rather than as a bare foreign key violation.
+func checkReference(ctx context.Context, q querier, ref reference) error {
+	t := lookupTables[ref.lookup]
+	var exists bool
+	err := q.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM "+t.table+" WHERE "+t.idColumn+" = ?)", ref.id).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return &storage.ReferenceError{Column: t.idColumn, ID: ref.id}
+	}
+	return nil
+}
+
+// referenceError turns the foreign key violation of a barang write into
+// ErrInvalidReference: the references were checked first, so a lookup row
+// was deleted in the meantime. They are checked again outside the failed
+// transaction to name the column.
+func (s *Store) referenceError(ctx context.Context, err error, refs []reference) error {
+	if !errors.Is(err, storage.ErrForeignKey) {
+		return err
+	}
+	for _, ref := range refs {
+		if refErr := checkReference(ctx, s.db, ref); refErr != nil {
+			return refErr
+		}
+	}
+	return fmt.Errorf("kategori, jenis or material %w", storage.ErrInvalidReference)
+}
+
+func getBarang(ctx context.Context, q querier, idBarang int64) (storage.Barang, error) {
+	var barang storage.Barang
+	err := q.QueryRowContext(ctx, selectBarang+" WHERE b.id_barang = ?", idBarang).Scan(
+		&barang.IDBarang, &barang.NamaBarang, &barang.FotoBarang, &barang.Harga,
+		&barang.IDKategori, &barang.NamaKategori,
+		&barang.IDJenis, &barang.NamaJenis,
+		&barang.IDMaterial, &barang.NamaMaterial,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.Barang{}, fmt.Errorf("barang %d %w", idBarang, storage.ErrNotFound)
+	}
+	return barang, err
+}
+
+func (s *Store) CreateBarang(ctx context.Context, barang storage.NewBarang) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	refs := []reference{
+		{storage.Kategori, barang.IDKategori},
+		{storage.Jenis, barang.IDJenis},
+		{storage.Material, barang.IDMaterial},
+	}
+	for _, ref := range refs {
+		if err := checkReference(ctx, tx, ref); err != nil {
+			return 0, err
+		}
+	}
+
+	idBarang, err := s.insertID(ctx, tx, "INSERT INTO barang (nama_barang, foto_barang, harga, id_kategori, id_jenis, id_material) VALUES (?,?,?,?,?,?)", "id_barang",
+		barang.NamaBarang, barang.FotoBarang, barang.Harga, barang.IDKategori, barang.IDJenis, barang.IDMaterial)
+	if err != nil {
+		tx.Rollback()
+		return 0, s.referenceError(ctx, err, refs)
+	}
+	return idBarang, tx.Commit()
+}
+
+func (s *Store) GetBarang(ctx context.Context, idBarang int64) (storage.Barang, error) {
+	return getBarang(ctx, s.db, idBarang)
+}
+
+func (s *Store) UpdateBarang(ctx context.Context, idBarang int64, update storage.BarangUpdate) (storage.Barang, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return storage.Barang{}, err
+	}
+	defer tx.Rollback()
+
+	if _, err := getBarang(ctx, tx, idBarang); err != nil {
+		return storage.Barang{}, err
+	}
+
+	var sets []string
+	var args []interface{}
+	if update.NamaBarang != nil {
+		sets = append(sets, "nama_barang = ?")
+		args = append(args, *update.NamaBarang)
+	}
+	if update.FotoBarang != nil {
+		sets = append(sets, "foto_barang = ?")
+		args = append(args, *update.FotoBarang)
+	}
+	if update.Harga != nil {
+		sets = append(sets, "harga = ?")
+		args = append(args, *update.Harga)
+	}
+	var refs []reference
+	for _, field := range []struct {
+		lookup storage.Lookup
+		id     *int64
+	}{
+		{storage.Kategori, update.IDKategori},
+		{storage.Jenis, update.IDJenis},
+		{storage.Material, update.IDMaterial},
+	} {
+		if field.id == nil {
+			continue
+		}
+		ref := reference{field.lookup, *field.id}
+		if err := checkReference(ctx, tx, ref); err != nil {
+			return storage.Barang{}, err
+		}
+		refs = append(refs, ref)
+		sets = append(sets, lookupTables[ref.lookup].idColumn+" = ?")
+		args = append(args, ref.id)
+	}
+
+	if len(sets) > 0 {
+		args = append(args, idBarang)
+		if _, err := tx.ExecContext(ctx, "UPDATE barang SET "+strings.Join(sets, ", ")+" WHERE id_barang = ?", args...); err != nil {
+			tx.Rollback()
+			return storage.Barang{}, s.referenceError(ctx, err, refs)
+		}
+	}
+
+	barang, err := getBarang(ctx, tx, idBarang)
+	if err != nil {
+		return storage.Barang{}, err
+	}
+	return barang, tx.Commit()
+}
+
+func (s *Store) DeleteBarang(ctx context.Context, idBarang int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var hasBatch bool
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM ref_barang WHERE id_barang = ?)", idBarang).Scan(&hasBatch)
+	if err != nil {
+		return err
+	}
+	if hasBatch {
+		return fmt.Errorf("barang %d %w by ref_barang", idBarang, storage.ErrReferenced)
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM barang WHERE id_barang = ?", idBarang)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("barang %d %w", idBarang, storage.ErrNotFound)
+	}
+	return tx.Commit()
+}
+
+func (s *Store) ListBarangByLookup(ctx context.Context, lookup storage.Lookup, page storage.Page) ([]storage.Barang, int32, error) {
+	t := lookupTables[lookup]
+	from := "FROM barang b INNER JOIN " + t.table + " k on b." + t.idColumn + " = k." + t.idColumn
+	total, err := s.count(ctx, from)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	scan := func(rows *sql.Rows) (storage.Barang, error) {
+		var barang storage.Barang
+		var nama string
+		err := rows.Scan(&barang.IDBarang, &barang.NamaBarang, &barang.FotoBarang, &nama, &barang.Harga)
+		switch lookup {
+		case storage.Kategori:
+			barang.NamaKategori = nama
+		case storage.Jenis:
+			barang.NamaJenis = nama
+		case storage.Material:
+			barang.NamaMaterial = nama
+		}
+		return barang, err
+	}
+	result, err := collect(ctx, s.db, scan, "SELECT b.id_barang, b.nama_barang, b.foto_barang, k."+t.nameColumn+", b.harga "+from+" WHERE b.id_barang > ? ORDER BY b.id_barang LIMIT ?", afterID(page), page.Limit)
+	return result, total, err
+}
+
+func (s *Store) UpdateHargaByBatch(ctx context.Context, noBatch string, harga int32) error {
+	result, err := s.db.ExecContext(ctx, s.dialect.updateHargaByBatch, harga, noBatch)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected > 0 {
+		return err
+	}
+
+	// MySQL counts changed rows, not matched ones, so 0 can also mean the
+	// barang already had this harga.
+	var exists bool
+	if err := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM ref_barang WHERE no_batch = ?)", noBatch).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("no_batch %q %w", noBatch, storage.ErrNotFound)
+	}
+	return nil
+}
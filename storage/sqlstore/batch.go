@@ -0,0 +1,311 @@
+// storage/sqlstore/batch.go
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"grpc_crud/storage"
+	"strings"
+)
+
+// FROM clauses shared by the List* queries and their Each* variants. The
+// expiry clauses take the business date as their only argument.
+const (
+	itemsFrom      = "FROM ref_barang rb INNER JOIN barang b ON rb.id_barang = b.id_barang INNER JOIN kategori k ON b.id_kategori = k.id_kategori INNER JOIN material m ON b.id_material = m.id_material INNER JOIN jenis j ON b.id_jenis = j.id_jenis"
+	expiredFrom    = "FROM ref_barang rb INNER JOIN barang b ON rb.id_barang = b.id_barang WHERE rb.expired < ?"
+	notExpiredFrom = "FROM ref_barang rb INNER JOIN barang b ON rb.id_barang = b.id_barang WHERE rb.expired >= ?"
+	selectItems    = "SELECT rb.id_ref_barang, b.nama_barang, b.foto_barang, b.harga, k.nama_kategori, j.nama_jenis, rb.no_batch "
+	selectExpiry   = "SELECT rb.id_ref_barang, b.nama_barang, rb.stok, rb.no_batch, rb.expired "
+)
+
+const selectBatch = "SELECT rb.id_ref_barang, b.id_barang, b.nama_barang, b.foto_barang, b.harga, rb.no_batch, rb.stok, rb.expired FROM ref_barang rb INNER JOIN barang b ON rb.id_barang = b.id_barang"
+
+func scanItem(rows *sql.Rows) (storage.Batch, error) {
+	var row storage.Batch
+	err := rows.Scan(&row.IDRefBarang, &row.NamaBarang, &row.FotoBarang, &row.Harga, &row.NamaKategori, &row.NamaJenis, &row.NoBatch)
+	return row, err
+}
+
+func scanExpiry(rows *sql.Rows) (storage.Batch, error) {
+	var row storage.Batch
+	err := rows.Scan(&row.IDRefBarang, &row.NamaBarang, &row.Stok, &row.NoBatch, dateColumn{&row.Expired})
+	return row, err
+}
+
+func (s *Store) ListItems(ctx context.Context, filter storage.ItemFilter, sort storage.ItemSort, page storage.Page) ([]storage.Batch, int32, error) {
+	conds, args := itemFilter(filter)
+
+	from := itemsFrom
+	if len(conds) > 0 {
+		from += " WHERE " + strings.Join(conds, " AND ")
+	}
+	total, err := s.count(ctx, from, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if page.After != nil {
+		cond, keyArgs, err := itemKeyset(sort, *page.After)
+		if err != nil {
+			return nil, 0, err
+		}
+		conds = append(conds, cond)
+		args = append(args, keyArgs...)
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
+	}
+	args = append(args, page.Limit)
+
+	result, err := collect(ctx, s.db, scanItem, selectItems+itemsFrom+where+" "+itemOrder(sort)+" LIMIT ?", args...)
+	return result, total, err
+}
+
+func (s *Store) EachItem(ctx context.Context, fn func(storage.Batch) error) error {
+	return each(ctx, s.db, scanItem, fn, selectItems+itemsFrom+" ORDER BY rb.id_ref_barang")
+}
+
+func (s *Store) ListBatches(ctx context.Context, page storage.Page) ([]storage.Batch, int32, error) {
+	from := "FROM barang b INNER JOIN ref_barang k on b.id_barang = k.id_barang"
+	total, err := s.count(ctx, from)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	scan := func(rows *sql.Rows) (storage.Batch, error) {
+		var row storage.Batch
+		err := rows.Scan(&row.IDRefBarang, &row.NamaBarang, &row.FotoBarang, &row.NoBatch, &row.Harga)
+		return row, err
+	}
+	result, err := collect(ctx, s.db, scan, "SELECT k.id_ref_barang, b.nama_barang, b.foto_barang, k.no_batch, b.harga "+from+" WHERE k.id_ref_barang > ? ORDER BY k.id_ref_barang LIMIT ?", afterID(page), page.Limit)
+	return result, total, err
+}
+
+func (s *Store) listExpiry(ctx context.Context, from, asOf string, page storage.Page) ([]storage.Batch, int32, error) {
+	total, err := s.count(ctx, from, asOf)
+	if err != nil {
+		return nil, 0, err
+	}
+	result, err := collect(ctx, s.db, scanExpiry, selectExpiry+from+" AND rb.id_ref_barang > ? ORDER BY rb.id_ref_barang LIMIT ?", asOf, afterID(page), page.Limit)
+	return result, total, err
+}
+
+func (s *Store) ListExpired(ctx context.Context, asOf string, page storage.Page) ([]storage.Batch, int32, error) {
+	return s.listExpiry(ctx, expiredFrom, asOf, page)
+}
+
+func (s *Store) ListNotExpired(ctx context.Context, asOf string, page storage.Page) ([]storage.Batch, int32, error) {
+	return s.listExpiry(ctx, notExpiredFrom, asOf, page)
+}
+
+func (s *Store) EachExpired(ctx context.Context, asOf string, fn func(storage.Batch) error) error {
+	return each(ctx, s.db, scanExpiry, fn, selectExpiry+expiredFrom+" ORDER BY rb.id_ref_barang", asOf)
+}
+
+func (s *Store) EachNotExpired(ctx context.Context, asOf string, fn func(storage.Batch) error) error {
+	return each(ctx, s.db, scanExpiry, fn, selectExpiry+notExpiredFrom+" ORDER BY rb.id_ref_barang", asOf)
+}
+
+func (s *Store) ListExpiring(ctx context.Context, query storage.ExpiringQuery, page storage.Page) ([]storage.Batch, int32, error) {
+	conds := []string{"rb.expired >= ?", "rb.expired <= ?"}
+	args := []interface{}{query.Start, query.End}
+	if query.IDKategori != 0 {
+		conds = append(conds, "b.id_kategori = ?")
+		args = append(args, query.IDKategori)
+	}
+
+	from := "FROM ref_barang rb INNER JOIN barang b ON rb.id_barang = b.id_barang INNER JOIN kategori k ON b.id_kategori = k.id_kategori WHERE " + strings.Join(conds, " AND ")
+	total, err := s.count(ctx, from, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if page.After != nil {
+		from += " AND (rb.expired > ? OR (rb.expired = ? AND rb.id_ref_barang > ?))"
+		args = append(args, page.After.Value, page.After.Value, page.After.ID)
+	}
+	args = append(args, page.Limit)
+
+	scan := func(rows *sql.Rows) (storage.Batch, error) {
+		var row storage.Batch
+		err := rows.Scan(&row.IDRefBarang, &row.NamaBarang, &row.NoBatch, &row.Stok, dateColumn{&row.Expired}, &row.NamaKategori)
+		return row, err
+	}
+	result, err := collect(ctx, s.db, scan, "SELECT rb.id_ref_barang, b.nama_barang, rb.no_batch, rb.stok, rb.expired, k.nama_kategori "+from+" ORDER BY rb.expired, rb.id_ref_barang LIMIT ?", args...)
+	return result, total, err
+}
+
+// resolveBatch turns a BatchKey into an id_ref_barang.
+func resolveBatch(ctx context.Context, tx dialectTx, key storage.BatchKey) (int64, error) {
+	if key.NoBatch == "" {
+		return key.IDRefBarang, nil
+	}
+
+	scan := func(rows *sql.Rows) (int64, error) {
+		var id int64
+		err := rows.Scan(&id)
+		return id, err
+	}
+	ids, err := collect(ctx, tx, scan, "SELECT id_ref_barang FROM ref_barang WHERE no_batch = ? LIMIT 2", key.NoBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("batch %q %w", key.NoBatch, storage.ErrNotFound)
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, fmt.Errorf("no_batch %q %w, use id_ref_barang", key.NoBatch, storage.ErrAmbiguous)
+	}
+}
+
+func getBatch(ctx context.Context, q querier, idRefBarang int64) (storage.Batch, error) {
+	var batch storage.Batch
+	err := q.QueryRowContext(ctx, selectBatch+" WHERE rb.id_ref_barang = ?", idRefBarang).Scan(
+		&batch.IDRefBarang, &batch.IDBarang, &batch.NamaBarang, &batch.FotoBarang, &batch.Harga,
+		&batch.NoBatch, &batch.Stok, dateColumn{&batch.Expired},
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.Batch{}, fmt.Errorf("batch %d %w", idRefBarang, storage.ErrNotFound)
+	}
+	return batch, err
+}
+
+func (s *Store) GetBatch(ctx context.Context, key storage.BatchKey) (storage.Batch, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return storage.Batch{}, err
+	}
+	defer tx.Rollback()
+
+	idRefBarang, err := resolveBatch(ctx, tx, key)
+	if err != nil {
+		return storage.Batch{}, err
+	}
+	return getBatch(ctx, tx, idRefBarang)
+}
+
+func (s *Store) UpdateBatch(ctx context.Context, key storage.BatchKey, update storage.BatchUpdate) (storage.Batch, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return storage.Batch{}, err
+	}
+	defer tx.Rollback()
+
+	idRefBarang, err := resolveBatch(ctx, tx, key)
+	if err != nil {
+		return storage.Batch{}, err
+	}
+	if _, err := getBatch(ctx, tx, idRefBarang); err != nil {
+		return storage.Batch{}, err
+	}
+
+	var sets []string
+	var args []interface{}
+	if update.Stok != nil {
+		sets = append(sets, "stok = ?")
+		args = append(args, *update.Stok)
+	}
+	if update.Expired != nil {
+		sets = append(sets, "expired = ?")
+		args = append(args, *update.Expired)
+	}
+	if len(sets) > 0 {
+		args = append(args, idRefBarang)
+		if _, err := tx.ExecContext(ctx, "UPDATE ref_barang SET "+strings.Join(sets, ", ")+" WHERE id_ref_barang = ?", args...); err != nil {
+			return storage.Batch{}, err
+		}
+	}
+
+	batch, err := getBatch(ctx, tx, idRefBarang)
+	if err != nil {
+		return storage.Batch{}, err
+	}
+	return batch, tx.Commit()
+}
+
+func (s *Store) DeleteBatch(ctx context.Context, key storage.BatchKey) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	idRefBarang, err := resolveBatch(ctx, tx, key)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM ref_barang WHERE id_ref_barang = ?", idRefBarang)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("batch %d %w", idRefBarang, storage.ErrNotFound)
+	}
+	return tx.Commit()
+}
+
+func (s *Store) CreateBatches(ctx context.Context, batches []storage.NewBatch, bestEffort bool) ([]storage.BatchFailure, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, s.dialect.insertRefBarang)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var failures []storage.BatchFailure
+	for i, batch := range batches {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if s.dialect.rowSavepoints {
+			if _, err := tx.ExecContext(ctx, "SAVEPOINT bulk_row"); err != nil {
+				return nil, err
+			}
+		}
+		_, err := stmt.ExecContext(ctx, batch.IDBarang, batch.Stok, batch.Expired, batch.NoBatch)
+		if err != nil {
+			rowErr := dbError(err)
+			switch {
+			case errors.Is(rowErr, storage.ErrForeignKey):
+				// id_barang is the only foreign key of ref_barang.
+				rowErr = &storage.ReferenceError{Column: "id_barang", ID: batch.IDBarang}
+			case errors.Is(rowErr, storage.ErrDuplicate), errors.Is(rowErr, storage.ErrInvalidValue):
+			default:
+				// Not a fault of the row, e.g. a deadlock or a lost
+				// connection: the transaction cannot go on.
+				return nil, rowErr
+			}
+			failures = append(failures, storage.BatchFailure{Index: i, Err: rowErr})
+		}
+		if s.dialect.rowSavepoints {
+			release := "RELEASE SAVEPOINT bulk_row"
+			if err != nil {
+				release = "ROLLBACK TO SAVEPOINT bulk_row"
+			}
+			if _, err := tx.ExecContext(ctx, release); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(failures) > 0 && !bestEffort {
+		return failures, tx.Rollback()
+	}
+	return failures, tx.Commit()
+}
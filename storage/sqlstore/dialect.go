@@ -0,0 +1,88 @@
+// storage/sqlstore/dialect.go
+package sqlstore
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Dialect holds what is spelled differently per database. Queries are
+// written with ? placeholders in the SQL that MySQL, SQLite and PostgreSQL
+// share, and rewritten by rebind where the dialect needs it.
+type Dialect struct {
+	name string
+	// updateHargaByBatch takes harga, then no_batch.
+	updateHargaByBatch string
+	// insertRefBarang takes id_barang, stok, expired and no_batch.
+	insertRefBarang string
+	// countMigrationsTable counts the schema_migrations tables of the
+	// current database, 0 or 1.
+	countMigrationsTable string
+	// numberedParams means placeholders are $1, $2, ... instead of ?.
+	numberedParams bool
+	// returning means generated ids are read with INSERT ... RETURNING since
+	// the driver does not implement sql.Result.LastInsertId.
+	returning bool
+	// rowSavepoints means a failed statement aborts the whole transaction,
+	// so CreateBatches needs a savepoint around every row to carry on.
+	rowSavepoints bool
+}
+
+func (d Dialect) String() string {
+	return d.name
+}
+
+var (
+	// MySQL is the dialect of the original db_skripsi_2 database.
+	MySQL = Dialect{
+		name:                 "mysql",
+		updateHargaByBatch:   "UPDATE barang b INNER JOIN ref_barang rb ON rb.id_barang = b.id_barang SET b.harga =? WHERE rb.no_batch =?",
+		insertRefBarang:      "INSERT INTO `ref_barang` (`id_ref_barang`, `id_barang`, `stok`, `expired`, `no_batch`, `created_date`) VALUES (NULL,?,?,?,?, current_timestamp());",
+		countMigrationsTable: "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = 'schema_migrations'",
+	}
+
+	// SQLite has no multi-table UPDATE and spells current_timestamp() without
+	// the parentheses.
+	SQLite = Dialect{
+		name:                 "sqlite",
+		updateHargaByBatch:   "UPDATE barang SET harga = ? FROM ref_barang rb WHERE rb.id_barang = barang.id_barang AND rb.no_batch = ?",
+		insertRefBarang:      "INSERT INTO ref_barang (id_barang, stok, expired, no_batch, created_date) VALUES (?,?,?,?, CURRENT_TIMESTAMP)",
+		countMigrationsTable: "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations'",
+	}
+
+	// PostgreSQL uses UPDATE ... FROM like SQLite, and on top of that numbered
+	// placeholders and no backtick identifiers.
+	PostgreSQL = Dialect{
+		name:                 "postgres",
+		updateHargaByBatch:   "UPDATE barang SET harga = ? FROM ref_barang rb WHERE rb.id_barang = barang.id_barang AND rb.no_batch = ?",
+		insertRefBarang:      "INSERT INTO ref_barang (id_barang, stok, expired, no_batch, created_date) VALUES (?,?,?,?, CURRENT_TIMESTAMP)",
+		countMigrationsTable: "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = 'schema_migrations'",
+		numberedParams:       true,
+		returning:            true,
+		rowSavepoints:        true,
+	}
+)
+
+// rebind rewrites the ? placeholders of query into the form of the dialect.
+// Question marks inside string literals are left alone.
+func (d Dialect) rebind(query string) string {
+	if !d.numberedParams {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	quoted := false
+	for _, r := range query {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == '?' && !quoted:
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
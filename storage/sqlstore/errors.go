@@ -0,0 +1,102 @@
+// storage/sqlstore/errors.go
+package sqlstore
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"grpc_crud/storage"
+	"net"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// MySQL error numbers classified by dbError.
+const (
+	mysqlTooManyConns     = 1040
+	mysqlBadNull          = 1048
+	mysqlDupEntry         = 1062
+	mysqlLockWaitTimeout  = 1205
+	mysqlLockDeadlock     = 1213
+	mysqlNoReferencedRow  = 1216
+	mysqlRowIsReferenced  = 1217
+	mysqlWarnDataRange    = 1264
+	mysqlTruncatedValue   = 1292
+	mysqlTruncatedField   = 1366
+	mysqlDataTooLong      = 1406
+	mysqlRowIsReferenced2 = 1451
+	mysqlNoReferencedRow2 = 1452
+	mysqlQueryInterrupted = 3024 // max_execution_time exceeded
+)
+
+// dbError classifies an error of any of the three drivers as a
+// storage.DatabaseError. Errors it does not recognise, sql.ErrNoRows and
+// context errors are returned unchanged.
+func dbError(err error) error {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	var kind error
+	var mysqlErr *mysql.MySQLError
+	var pgErr *pgconn.PgError
+	var sqliteErr *sqlite.Error
+	var netErr net.Error
+	switch {
+	case errors.As(err, &mysqlErr):
+		switch mysqlErr.Number {
+		case mysqlDupEntry:
+			kind = storage.ErrDuplicate
+		case mysqlRowIsReferenced, mysqlRowIsReferenced2, mysqlNoReferencedRow, mysqlNoReferencedRow2:
+			kind = storage.ErrForeignKey
+		case mysqlBadNull, mysqlWarnDataRange, mysqlTruncatedValue, mysqlTruncatedField, mysqlDataTooLong:
+			kind = storage.ErrInvalidValue
+		case mysqlTooManyConns, mysqlLockWaitTimeout, mysqlLockDeadlock, mysqlQueryInterrupted:
+			kind = storage.ErrUnavailable
+		}
+	case errors.As(err, &pgErr):
+		switch {
+		case pgErr.Code == "23505": // unique_violation
+			kind = storage.ErrDuplicate
+		case pgErr.Code == "23503": // foreign_key_violation
+			kind = storage.ErrForeignKey
+		case pgErr.Code == "23502", // not_null_violation
+			pgErr.Code == "23514",               // check_violation
+			strings.HasPrefix(pgErr.Code, "22"): // data_exception
+			kind = storage.ErrInvalidValue
+		case pgErr.Code == "57014", // query_canceled, e.g. by statement_timeout
+			pgErr.Code == "55P03",               // lock_not_available
+			pgErr.Code == "40P01",               // deadlock_detected
+			pgErr.Code == "40001",               // serialization_failure
+			pgErr.Code == "53300",               // too_many_connections
+			pgErr.Code == "57P01",               // admin_shutdown
+			strings.HasPrefix(pgErr.Code, "08"): // connection_exception
+			kind = storage.ErrUnavailable
+		}
+	case errors.As(err, &sqliteErr):
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+			kind = storage.ErrDuplicate
+		case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+			kind = storage.ErrForeignKey
+		case sqlite3.SQLITE_CONSTRAINT_NOTNULL, sqlite3.SQLITE_CONSTRAINT_CHECK:
+			kind = storage.ErrInvalidValue
+		}
+		switch sqliteErr.Code() & 0xff {
+		case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+			kind = storage.ErrUnavailable
+		}
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, mysql.ErrInvalidConn),
+		errors.As(err, new(*pgconn.ConnectError)), errors.As(err, &netErr):
+		kind = storage.ErrUnavailable
+	}
+
+	if kind == nil {
+		return err
+	}
+	return &storage.DatabaseError{Kind: kind, Cause: err}
+}
@@ -0,0 +1,36 @@
+// storage/sqlstore/errors_test.go
+package sqlstore
+
+import (
+	"errors"
+	"grpc_crud/storage"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestDBError(t *testing.T) {
+	for _, test := range []struct {
+		err  error
+		want error
+	}{
+		{&mysql.MySQLError{Number: mysqlDupEntry}, storage.ErrDuplicate},
+		{&mysql.MySQLError{Number: mysqlNoReferencedRow2}, storage.ErrForeignKey},
+		{&mysql.MySQLError{Number: mysqlDataTooLong}, storage.ErrInvalidValue},
+		{&mysql.MySQLError{Number: mysqlLockDeadlock}, storage.ErrUnavailable},
+		{&pgconn.PgError{Code: "23503"}, storage.ErrForeignKey},
+		{&pgconn.PgError{Code: "22001"}, storage.ErrInvalidValue}, // string_data_right_truncation
+		{&pgconn.PgError{Code: "40P01"}, storage.ErrUnavailable},
+		{&pgconn.PgError{Code: "40001"}, storage.ErrUnavailable},
+	} {
+		if got := dbError(test.err); !errors.Is(got, test.want) {
+			t.Errorf("dbError(%v) = %v, want %v", test.err, got, test.want)
+		}
+	}
+
+	unknown := &mysql.MySQLError{Number: 1064} // syntax error
+	if got := dbError(unknown); got != error(unknown) {
+		t.Errorf("dbError(%v) = %v, want it unchanged", unknown, got)
+	}
+}
@@ -0,0 +1,99 @@
+// storage/sqlstore/filter.go
+package sqlstore
+
+import (
+	"fmt"
+	"grpc_crud/storage"
+	"strconv"
+	"strings"
+)
+
+// likeEscaper escapes the LIKE wildcards in user input. '!' is used as the
+// escape character because, unlike backslash, it means the same thing in
+// every SQL dialect.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// itemFilter compiles an ItemFilter into WHERE conditions and their
+// arguments. Client values only ever reach the query as arguments. Both LIKE
+// conditions compare lower-cased, which is what MySQL does anyway under its
+// default collation and keeps PostgreSQL from being case-sensitive.
+func itemFilter(filter storage.ItemFilter) ([]string, []interface{}) {
+	var conds []string
+	var args []interface{}
+
+	if filter.IDKategori != 0 {
+		conds = append(conds, "b.id_kategori = ?")
+		args = append(args, filter.IDKategori)
+	}
+	if filter.IDJenis != 0 {
+		conds = append(conds, "b.id_jenis = ?")
+		args = append(args, filter.IDJenis)
+	}
+	if filter.IDMaterial != 0 {
+		conds = append(conds, "b.id_material = ?")
+		args = append(args, filter.IDMaterial)
+	}
+	if filter.HargaMin != nil {
+		conds = append(conds, "b.harga >= ?")
+		args = append(args, *filter.HargaMin)
+	}
+	if filter.HargaMax != nil {
+		conds = append(conds, "b.harga <= ?")
+		args = append(args, *filter.HargaMax)
+	}
+	if filter.NamaContains != "" {
+		conds = append(conds, "LOWER(b.nama_barang) LIKE ? ESCAPE '!'")
+		args = append(args, "%"+likeEscaper.Replace(strings.ToLower(filter.NamaContains))+"%")
+	}
+	if filter.BatchPrefix != "" {
+		conds = append(conds, "LOWER(rb.no_batch) LIKE ? ESCAPE '!'")
+		args = append(args, likeEscaper.Replace(strings.ToLower(filter.BatchPrefix))+"%")
+	}
+	return conds, args
+}
+
+// itemSortColumns maps the sort fields of ListItems to their columns. The
+// string columns sort lower-cased so that SQLite and PostgreSQL order them
+// like MySQL does under its default collation.
+var itemSortColumns = map[string]string{
+	storage.SortByHarga:      "b.harga",
+	storage.SortByNamaBarang: "LOWER(b.nama_barang)",
+	storage.SortByNoBatch:    "LOWER(rb.no_batch)",
+}
+
+// itemOrder always tie-breaks on rb.id_ref_barang so that the order is total
+// and keyset pages are stable.
+func itemOrder(sort storage.ItemSort) string {
+	column, ok := itemSortColumns[sort.Field]
+	if !ok {
+		return "ORDER BY rb.id_ref_barang"
+	}
+	if sort.Desc {
+		return "ORDER BY " + column + " DESC, rb.id_ref_barang DESC"
+	}
+	return "ORDER BY " + column + ", rb.id_ref_barang"
+}
+
+// itemKeyset returns the condition selecting the rows after the cursor.
+func itemKeyset(sort storage.ItemSort, after storage.Cursor) (string, []interface{}, error) {
+	column, ok := itemSortColumns[sort.Field]
+	if !ok {
+		return "rb.id_ref_barang > ?", []interface{}{after.ID}, nil
+	}
+
+	var value interface{} = strings.ToLower(after.Value)
+	if sort.Field == storage.SortByHarga {
+		harga, err := strconv.Atoi(after.Value)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid harga cursor %q: %w", after.Value, err)
+		}
+		value = harga
+	}
+
+	op := ">"
+	if sort.Desc {
+		op = "<"
+	}
+	cond := "(" + column + " " + op + " ? OR (" + column + " = ? AND rb.id_ref_barang " + op + " ?))"
+	return cond, []interface{}{value, value, after.ID}, nil
+}
@@ -0,0 +1,195 @@
+// storage/sqlstore/migrate.go
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// migrationFiles holds NNNN_name.up.sql and NNNN_name.down.sql per dialect.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// Migration is one versioned schema change.
+type Migration struct {
+	Version int
+	Name    string
+	// AppliedAt is when the migration was applied, empty while pending.
+	AppliedAt string
+	up, down  string
+}
+
+const createMigrationsTable = "CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP)"
+
+// Migrations returns the migrations of the dialect ordered by version.
+func Migrations(dialect Dialect) ([]Migration, error) {
+	dir := path.Join("migrations", dialect.name)
+	entries, err := fs.ReadDir(migrationFiles, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		base, direction, ok := strings.Cut(strings.TrimSuffix(entry.Name(), ".sql"), ".")
+		number, name, ok2 := strings.Cut(base, "_")
+		version, err := strconv.Atoi(number)
+		if !ok || !ok2 || err != nil || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("migration file %s is not named NNNN_name.up.sql or NNNN_name.down.sql", entry.Name())
+		}
+
+		body, err := fs.ReadFile(migrationFiles, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if direction == "up" {
+			m.up = string(body)
+		} else {
+			m.down = string(body)
+		}
+	}
+
+	var result []Migration
+	for _, m := range byVersion {
+		if m.up == "" || m.down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", m.Version, m.Name)
+		}
+		result = append(result, *m)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+// statements splits a migration file on the semicolons ending its
+// statements. Not every driver accepts several statements in one Exec.
+func statements(body string) []string {
+	var result []string
+	for _, stmt := range strings.Split(body, ";") {
+		var code []string
+		for _, line := range strings.Split(stmt, "\n") {
+			if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "--") {
+				code = append(code, line)
+			}
+		}
+		if len(code) > 0 {
+			result = append(result, strings.Join(code, "\n"))
+		}
+	}
+	return result
+}
+
+// MigrationStatus returns every migration with AppliedAt set for those
+// already applied. It does not write to the database: without a
+// schema_migrations table every migration is pending.
+func MigrationStatus(ctx context.Context, db *sql.DB, dialect Dialect) ([]Migration, error) {
+	migrations, err := Migrations(dialect)
+	if err != nil {
+		return nil, err
+	}
+	conn := dialectDB{DB: db, dialect: dialect}
+	var tables int
+	if err := conn.QueryRowContext(ctx, dialect.countMigrationsTable).Scan(&tables); err != nil {
+		return nil, err
+	}
+	if tables == 0 {
+		return migrations, nil
+	}
+
+	scan := func(rows *sql.Rows) (Migration, error) {
+		var m Migration
+		err := rows.Scan(&m.Version, &m.AppliedAt)
+		return m, err
+	}
+	applied, err := collect(ctx, conn, scan, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range applied {
+		for i := range migrations {
+			if migrations[i].Version == a.Version {
+				migrations[i].AppliedAt = a.AppliedAt
+			}
+		}
+	}
+	return migrations, nil
+}
+
+// run executes the statements of one migration and records the new version
+// in the same transaction. MySQL commits DDL implicitly, so there a failed
+// migration can leave its first statements applied.
+func run(ctx context.Context, db *sql.DB, dialect Dialect, body string, record string, args ...interface{}) error {
+	tx, err := dialectDB{DB: db, dialect: dialect}.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range statements(body) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// MigrateUp applies every pending migration in version order and returns the
+// ones it applied.
+func MigrateUp(ctx context.Context, db *sql.DB, dialect Dialect) ([]Migration, error) {
+	if _, err := (dialectDB{DB: db, dialect: dialect}).ExecContext(ctx, createMigrationsTable); err != nil {
+		return nil, err
+	}
+	migrations, err := MigrationStatus(ctx, db, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, m := range migrations {
+		if m.AppliedAt != "" {
+			continue
+		}
+		if err := run(ctx, db, dialect, m.up, "INSERT INTO schema_migrations (version, name) VALUES (?, ?)", m.Version, m.Name); err != nil {
+			return applied, fmt.Errorf("migration %04d_%s: %w", m.Version, m.Name, err)
+		}
+		applied = append(applied, m)
+	}
+	return applied, nil
+}
+
+// MigrateDown reverts the latest steps applied migrations, newest first, and
+// returns the ones it reverted.
+func MigrateDown(ctx context.Context, db *sql.DB, dialect Dialect, steps int) ([]Migration, error) {
+	migrations, err := MigrationStatus(ctx, db, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+		m := migrations[i]
+		if m.AppliedAt == "" {
+			continue
+		}
+		if err := run(ctx, db, dialect, m.down, "DELETE FROM schema_migrations WHERE version = ?", m.Version); err != nil {
+			return reverted, fmt.Errorf("migration %04d_%s: %w", m.Version, m.Name, err)
+		}
+		reverted = append(reverted, m)
+	}
+	return reverted, nil
+}
@@ -0,0 +1,45 @@
+-- The db_skripsi_2 tables. IF NOT EXISTS lets a database that predates the
+-- migrations adopt them: its tables are kept and only the version is recorded.
+
+CREATE TABLE IF NOT EXISTS kategori (
+	id_kategori   INT NOT NULL AUTO_INCREMENT,
+	nama_kategori VARCHAR(100) NOT NULL,
+	PRIMARY KEY (id_kategori)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS jenis (
+	id_jenis   INT NOT NULL AUTO_INCREMENT,
+	nama_jenis VARCHAR(100) NOT NULL,
+	PRIMARY KEY (id_jenis)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS material (
+	id_material   INT NOT NULL AUTO_INCREMENT,
+	nama_material VARCHAR(100) NOT NULL,
+	PRIMARY KEY (id_material)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS barang (
+	id_barang   INT NOT NULL AUTO_INCREMENT,
+	nama_barang VARCHAR(255) NOT NULL,
+	foto_barang VARCHAR(255) NOT NULL DEFAULT '',
+	harga       INT NOT NULL,
+	id_kategori INT NOT NULL,
+	id_jenis    INT NOT NULL,
+	id_material INT NOT NULL,
+	PRIMARY KEY (id_barang),
+	CONSTRAINT fk_barang_kategori FOREIGN KEY (id_kategori) REFERENCES kategori (id_kategori),
+	CONSTRAINT fk_barang_jenis FOREIGN KEY (id_jenis) REFERENCES jenis (id_jenis),
+	CONSTRAINT fk_barang_material FOREIGN KEY (id_material) REFERENCES material (id_material)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS ref_barang (
+	id_ref_barang INT NOT NULL AUTO_INCREMENT,
+	id_barang     INT NOT NULL,
+	stok          INT NOT NULL,
+	expired       DATE NOT NULL,
+	no_batch      VARCHAR(50) NOT NULL,
+	created_date  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (id_ref_barang),
+	CONSTRAINT fk_ref_barang_barang FOREIGN KEY (id_barang) REFERENCES barang (id_barang)
+) ENGINE=InnoDB;
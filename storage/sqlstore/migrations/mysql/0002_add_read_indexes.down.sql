@@ -0,0 +1,14 @@
+-- InnoDB refuses to drop the only index a foreign key can use, so every
+-- foreign key first gets back the index it had before 0002, named after its
+-- constraint.
+
+CREATE INDEX fk_barang_material ON barang (id_material);
+DROP INDEX idx_barang_id_material ON barang;
+CREATE INDEX fk_barang_jenis ON barang (id_jenis);
+DROP INDEX idx_barang_id_jenis ON barang;
+CREATE INDEX fk_barang_kategori ON barang (id_kategori);
+DROP INDEX idx_barang_id_kategori ON barang;
+CREATE INDEX fk_ref_barang_barang ON ref_barang (id_barang);
+DROP INDEX idx_ref_barang_id_barang ON ref_barang;
+DROP INDEX idx_ref_barang_expired ON ref_barang;
+DROP INDEX idx_ref_barang_no_batch ON ref_barang;
@@ -0,0 +1,12 @@
+-- Indexes for the lookups by no_batch (GetBatch, UpdateHargaBatch), the
+-- expiry listings and the joins along the foreign keys. InnoDB would index
+-- the foreign key columns by itself, but only under the constraint names and
+-- only while no other index serves them; creating them here names them as on
+-- the other databases. InnoDB then drops the indexes it had made itself.
+
+CREATE INDEX idx_ref_barang_no_batch ON ref_barang (no_batch);
+CREATE INDEX idx_ref_barang_expired ON ref_barang (expired);
+CREATE INDEX idx_ref_barang_id_barang ON ref_barang (id_barang);
+CREATE INDEX idx_barang_id_kategori ON barang (id_kategori);
+CREATE INDEX idx_barang_id_jenis ON barang (id_jenis);
+CREATE INDEX idx_barang_id_material ON barang (id_material);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS ref_barang;
+DROP TABLE IF EXISTS barang;
+DROP TABLE IF EXISTS material;
+DROP TABLE IF EXISTS jenis;
+DROP TABLE IF EXISTS kategori;
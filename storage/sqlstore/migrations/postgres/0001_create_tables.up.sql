@@ -0,0 +1,35 @@
+-- The db_skripsi_2 tables.
+
+CREATE TABLE IF NOT EXISTS kategori (
+	id_kategori   INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+	nama_kategori VARCHAR(100) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS jenis (
+	id_jenis   INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+	nama_jenis VARCHAR(100) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS material (
+	id_material   INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+	nama_material VARCHAR(100) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS barang (
+	id_barang   INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+	nama_barang VARCHAR(255) NOT NULL,
+	foto_barang VARCHAR(255) NOT NULL DEFAULT '',
+	harga       INTEGER NOT NULL,
+	id_kategori INTEGER NOT NULL REFERENCES kategori (id_kategori),
+	id_jenis    INTEGER NOT NULL REFERENCES jenis (id_jenis),
+	id_material INTEGER NOT NULL REFERENCES material (id_material)
+);
+
+CREATE TABLE IF NOT EXISTS ref_barang (
+	id_ref_barang INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+	id_barang     INTEGER NOT NULL REFERENCES barang (id_barang),
+	stok          INTEGER NOT NULL,
+	expired       DATE NOT NULL,
+	no_batch      VARCHAR(50) NOT NULL,
+	created_date  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
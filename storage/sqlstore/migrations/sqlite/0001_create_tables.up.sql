@@ -0,0 +1,36 @@
+-- The db_skripsi_2 tables. expired is TEXT rather than DATE so that the
+-- driver hands it back exactly as stored, as YYYY-MM-DD.
+
+CREATE TABLE IF NOT EXISTS kategori (
+	id_kategori   INTEGER PRIMARY KEY AUTOINCREMENT,
+	nama_kategori TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS jenis (
+	id_jenis   INTEGER PRIMARY KEY AUTOINCREMENT,
+	nama_jenis TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS material (
+	id_material   INTEGER PRIMARY KEY AUTOINCREMENT,
+	nama_material TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS barang (
+	id_barang   INTEGER PRIMARY KEY AUTOINCREMENT,
+	nama_barang TEXT NOT NULL,
+	foto_barang TEXT NOT NULL DEFAULT '',
+	harga       INTEGER NOT NULL,
+	id_kategori INTEGER NOT NULL REFERENCES kategori (id_kategori),
+	id_jenis    INTEGER NOT NULL REFERENCES jenis (id_jenis),
+	id_material INTEGER NOT NULL REFERENCES material (id_material)
+);
+
+CREATE TABLE IF NOT EXISTS ref_barang (
+	id_ref_barang INTEGER PRIMARY KEY AUTOINCREMENT,
+	id_barang     INTEGER NOT NULL REFERENCES barang (id_barang),
+	stok          INTEGER NOT NULL,
+	expired       TEXT NOT NULL,
+	no_batch      TEXT NOT NULL,
+	created_date  TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_barang_id_material;
+DROP INDEX IF EXISTS idx_barang_id_jenis;
+DROP INDEX IF EXISTS idx_barang_id_kategori;
+DROP INDEX IF EXISTS idx_ref_barang_id_barang;
+DROP INDEX IF EXISTS idx_ref_barang_expired;
+DROP INDEX IF EXISTS idx_ref_barang_no_batch;
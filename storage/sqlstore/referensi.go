@@ -0,0 +1,92 @@
+// storage/sqlstore/referensi.go
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"grpc_crud/storage"
+)
+
+// lookupTable describes one of the kategori, jenis and material tables. They
+// share the same id/nama shape and are all referenced from barang.
+type lookupTable struct {
+	table      string
+	idColumn   string
+	nameColumn string
+}
+
+var lookupTables = map[storage.Lookup]lookupTable{
+	storage.Kategori: {table: "kategori", idColumn: "id_kategori", nameColumn: "nama_kategori"},
+	storage.Jenis:    {table: "jenis", idColumn: "id_jenis", nameColumn: "nama_jenis"},
+	storage.Material: {table: "material", idColumn: "id_material", nameColumn: "nama_material"},
+}
+
+func (s *Store) ListLookup(ctx context.Context, lookup storage.Lookup) ([]storage.LookupRow, error) {
+	t := lookupTables[lookup]
+	scan := func(rows *sql.Rows) (storage.LookupRow, error) {
+		var row storage.LookupRow
+		err := rows.Scan(&row.ID, &row.Nama)
+		return row, err
+	}
+	return collect(ctx, s.db, scan, "SELECT "+t.idColumn+", "+t.nameColumn+" FROM "+t.table+" ORDER BY "+t.idColumn)
+}
+
+func (s *Store) CreateLookup(ctx context.Context, lookup storage.Lookup, nama string) (storage.LookupRow, error) {
+	t := lookupTables[lookup]
+	id, err := s.insertID(ctx, s.db, "INSERT INTO "+t.table+" ("+t.nameColumn+") VALUES (?)", t.idColumn, nama)
+	if err != nil {
+		return storage.LookupRow{}, err
+	}
+	return storage.LookupRow{ID: id, Nama: nama}, nil
+}
+
+func (s *Store) RenameLookup(ctx context.Context, lookup storage.Lookup, id int64, nama string) (storage.LookupRow, error) {
+	t := lookupTables[lookup]
+
+	var current string
+	err := s.db.QueryRowContext(ctx, "SELECT "+t.nameColumn+" FROM "+t.table+" WHERE "+t.idColumn+" = ?", id).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.LookupRow{}, fmt.Errorf("%s %d %w", t.table, id, storage.ErrNotFound)
+	}
+	if err != nil {
+		return storage.LookupRow{}, err
+	}
+
+	if _, err := s.db.ExecContext(ctx, "UPDATE "+t.table+" SET "+t.nameColumn+" = ? WHERE "+t.idColumn+" = ?", nama, id); err != nil {
+		return storage.LookupRow{}, err
+	}
+	return storage.LookupRow{ID: id, Nama: nama}, nil
+}
+
+func (s *Store) DeleteLookup(ctx context.Context, lookup storage.Lookup, id int64) error {
+	t := lookupTables[lookup]
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var used int
+	if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM barang WHERE "+t.idColumn+" = ?", id).Scan(&used); err != nil {
+		return err
+	}
+	if used > 0 {
+		return fmt.Errorf("%s %d %w by %d barang", t.table, id, storage.ErrReferenced, used)
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM "+t.table+" WHERE "+t.idColumn+" = ?", id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s %d %w", t.table, id, storage.ErrNotFound)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,10 @@
+// storage/sqlstore/sqlite.go
+package sqlstore
+
+// SQLiteDSN returns the modernc.org/sqlite DSN for the database file at path.
+// Foreign keys are off by default in SQLite and have to be enabled on every
+// connection; the busy timeout makes a writer wait for another one instead
+// of failing with SQLITE_BUSY.
+func SQLiteDSN(path string) string {
+	return "file:" + path + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+}
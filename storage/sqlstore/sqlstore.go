@@ -0,0 +1,187 @@
+// storage/sqlstore/sqlstore.go
+
+// Package sqlstore implements the storage repositories with database/sql on
+// the db_skripsi_2 schema, in MySQL, SQLite or PostgreSQL.
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"grpc_crud/storage"
+	"time"
+)
+
+// Store implements storage.Store.
+type Store struct {
+	db      dialectDB
+	dialect Dialect
+}
+
+var _ storage.Store = (*Store)(nil)
+
+// New returns a Store using db, which must be a database of the given
+// dialect. The caller keeps ownership of db.
+func New(db *sql.DB, dialect Dialect) *Store {
+	return &Store{db: dialectDB{DB: db, dialect: dialect}, dialect: dialect}
+}
+
+// Ping checks that a connection to the database can be used.
+func (s *Store) Ping(ctx context.Context) error {
+	return dbError(s.db.PingContext(ctx))
+}
+
+// querier is satisfied by both dialectDB and dialectTx.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) row
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// dialectDB is a *sql.DB that rebinds every query for its dialect and
+// classifies its errors with dbError.
+type dialectDB struct {
+	*sql.DB
+	dialect Dialect
+}
+
+func (db dialectDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	rows, err := db.DB.QueryContext(ctx, db.dialect.rebind(query), args...)
+	return rows, dbError(err)
+}
+
+func (db dialectDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) row {
+	return row{db.DB.QueryRowContext(ctx, db.dialect.rebind(query), args...)}
+}
+
+func (db dialectDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	result, err := db.DB.ExecContext(ctx, db.dialect.rebind(query), args...)
+	return result, dbError(err)
+}
+
+func (db dialectDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialectTx, error) {
+	tx, err := db.DB.BeginTx(ctx, opts)
+	return dialectTx{Tx: tx, dialect: db.dialect}, dbError(err)
+}
+
+// dialectTx is a *sql.Tx that rebinds every query for its dialect and
+// classifies its errors with dbError.
+type dialectTx struct {
+	*sql.Tx
+	dialect Dialect
+}
+
+func (tx dialectTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	rows, err := tx.Tx.QueryContext(ctx, tx.dialect.rebind(query), args...)
+	return rows, dbError(err)
+}
+
+func (tx dialectTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) row {
+	return row{tx.Tx.QueryRowContext(ctx, tx.dialect.rebind(query), args...)}
+}
+
+func (tx dialectTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	result, err := tx.Tx.ExecContext(ctx, tx.dialect.rebind(query), args...)
+	return result, dbError(err)
+}
+
+func (tx dialectTx) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	stmt, err := tx.Tx.PrepareContext(ctx, tx.dialect.rebind(query))
+	return stmt, dbError(err)
+}
+
+func (tx dialectTx) Commit() error {
+	return dbError(tx.Tx.Commit())
+}
+
+// row is a *sql.Row whose Scan classifies its error with dbError.
+type row struct {
+	*sql.Row
+}
+
+func (r row) Scan(dest ...interface{}) error {
+	return dbError(r.Row.Scan(dest...))
+}
+
+// insertID runs an INSERT and returns the id the database generated for
+// idColumn.
+func (s *Store) insertID(ctx context.Context, q querier, query, idColumn string, args ...interface{}) (int64, error) {
+	if s.dialect.returning {
+		var id int64
+		err := q.QueryRowContext(ctx, query+" RETURNING "+idColumn, args...).Scan(&id)
+		return id, err
+	}
+
+	result, err := q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+// dateColumn scans a DATE column into a YYYY-MM-DD string, whether the
+// driver returns it as text or as a time.Time.
+type dateColumn struct {
+	dst *string
+}
+
+func (c dateColumn) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case time.Time:
+		*c.dst = v.Format("2006-01-02")
+	case []byte:
+		*c.dst = string(v)
+	case string:
+		*c.dst = v
+	default:
+		return fmt.Errorf("cannot scan %T into a date", src)
+	}
+	return nil
+}
+
+// count runs SELECT COUNT(*) over the same FROM/WHERE as a page query.
+func (s *Store) count(ctx context.Context, fromWhere string, args ...interface{}) (int32, error) {
+	var total int32
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) "+fromWhere, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// collect runs query and scans every row with scan.
+func collect[T any](ctx context.Context, q querier, scan func(*sql.Rows) (T, error), query string, args ...interface{}) ([]T, error) {
+	var result []T
+	err := each(ctx, q, scan, func(row T) error {
+		result = append(result, row)
+		return nil
+	}, query, args...)
+	return result, err
+}
+
+// each runs query and hands every scanned row to fn as soon as it is read.
+func each[T any](ctx context.Context, q querier, scan func(*sql.Rows) (T, error), fn func(T) error, query string, args ...interface{}) error {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		row, err := scan(rows)
+		if err != nil {
+			return dbError(err)
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return dbError(rows.Err())
+}
+
+// afterID returns the id to continue after, 0 on the first page.
+func afterID(page storage.Page) int64 {
+	if page.After == nil {
+		return 0
+	}
+	return page.After.ID
+}
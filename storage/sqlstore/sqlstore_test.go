@@ -0,0 +1,107 @@
+// storage/sqlstore/sqlstore_test.go
+package sqlstore_test
+
+import (
+	"context"
+	"database/sql"
+	"grpc_crud/storage"
+	"grpc_crud/storage/sqlstore"
+	"grpc_crud/storage/storagetest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+)
+
+func TestSQLite(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storage.Store {
+		db := open(t, "sqlite", sqlstore.SQLiteDSN(filepath.Join(t.TempDir(), "test.db")))
+		if _, err := sqlstore.MigrateUp(context.Background(), db, sqlstore.SQLite); err != nil {
+			t.Fatal(err)
+		}
+		return sqlstore.New(db, sqlstore.SQLite)
+	})
+}
+
+func TestMySQL(t *testing.T) {
+	testServer(t, "mysql", "GRPC_CRUD_TEST_MYSQL_DSN", sqlstore.MySQL)
+}
+
+func TestPostgres(t *testing.T) {
+	testServer(t, "pgx", "GRPC_CRUD_TEST_POSTGRES_DSN", sqlstore.PostgreSQL)
+}
+
+// testServer runs the suite against the database server whose DSN is in the
+// environment variable env, and is skipped when it is not set. Every test
+// migrates the database and empties the tables first, so never point it at
+// a database whose data you want to keep.
+func testServer(t *testing.T, driver, env string, dialect sqlstore.Dialect) {
+	dsn := os.Getenv(env)
+	if dsn == "" {
+		t.Skipf("%s not set", env)
+	}
+
+	storagetest.Run(t, func(t *testing.T) storage.Store {
+		ctx := context.Background()
+		db := open(t, driver, dsn)
+		if _, err := sqlstore.MigrateUp(ctx, db, dialect); err != nil {
+			t.Fatal(err)
+		}
+		for _, table := range []string{"ref_barang", "barang", "kategori", "jenis", "material"} {
+			if _, err := db.ExecContext(ctx, "DELETE FROM "+table); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return sqlstore.New(db, dialect)
+	})
+}
+
+func open(t *testing.T, driver, dsn string) *sql.DB {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestMigrationStatusReadOnly(t *testing.T) {
+	ctx := context.Background()
+	db := open(t, "sqlite", sqlstore.SQLiteDSN(filepath.Join(t.TempDir(), "test.db")))
+
+	migrations, err := sqlstore.MigrationStatus(ctx, db, sqlstore.SQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range migrations {
+		if m.AppliedAt != "" {
+			t.Errorf("migration %04d_%s applied on an empty database", m.Version, m.Name)
+		}
+	}
+	var tables int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master").Scan(&tables); err != nil {
+		t.Fatal(err)
+	}
+	if tables != 0 {
+		t.Errorf("MigrationStatus created %d tables", tables)
+	}
+
+	if _, err := sqlstore.MigrateUp(ctx, db, sqlstore.SQLite); err != nil {
+		t.Fatal(err)
+	}
+	migrations, err = sqlstore.MigrationStatus(ctx, db, sqlstore.SQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range migrations {
+		if m.AppliedAt == "" {
+			t.Errorf("migration %04d_%s pending after MigrateUp", m.Version, m.Name)
+		}
+	}
+	if _, err := sqlstore.MigrateDown(ctx, db, sqlstore.SQLite, len(migrations)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,280 @@
+// storage/storage.go
+
+// Package storage defines the data layer the gRPC handlers depend on. The
+// handlers only see the repository interfaces below; sqlstore implements them
+// on top of database/sql and memstore keeps everything in memory.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Implementations wrap these errors with the subject in front, e.g.
+// fmt.Errorf("barang %d %w", id, ErrNotFound) reads "barang 7 not found".
+var (
+	// ErrNotFound is returned when the addressed row does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidReference is returned when a barang would point to a
+	// kategori, jenis or material that does not exist, or a lot to a barang
+	// that does not exist, usually as a *ReferenceError.
+	ErrInvalidReference = errors.New("does not exist")
+	// ErrReferenced is returned when deleting a row that other rows still use.
+	ErrReferenced = errors.New("is still referenced")
+	// ErrAmbiguous is returned when a no_batch matches more than one lot.
+	ErrAmbiguous = errors.New("matches more than one lot")
+	// ErrDuplicate is returned when a row violates a unique key.
+	ErrDuplicate = errors.New("already exists")
+	// ErrForeignKey is returned when the database rejects a write that
+	// would break a foreign key.
+	ErrForeignKey = errors.New("violates a foreign key")
+	// ErrInvalidValue is returned when the database rejects a value that
+	// does not fit its column, e.g. a string that is too long or a date out
+	// of range.
+	ErrInvalidValue = errors.New("has a value the database rejected")
+	// ErrUnavailable is returned when the database cannot be reached or
+	// gave up on a statement, e.g. on a lock wait timeout; retrying later
+	// may succeed.
+	ErrUnavailable = errors.New("database unavailable")
+)
+
+// ReferenceError is the ErrInvalidReference of a write naming a missing row
+// by its id column, e.g. "id_kategori 5 does not exist".
+type ReferenceError struct {
+	Column string
+	ID     int64
+}
+
+func (e *ReferenceError) Error() string {
+	return fmt.Sprintf("%s %d %v", e.Column, e.ID, ErrInvalidReference)
+}
+
+func (e *ReferenceError) Unwrap() error { return ErrInvalidReference }
+
+// DatabaseError is a database error classified as one of the errors above.
+// Its message is only that of Kind, so driver text naming tables and
+// constraints stays out of responses; Cause keeps it for the server log.
+type DatabaseError struct {
+	Kind  error
+	Cause error
+}
+
+func (e *DatabaseError) Error() string { return e.Kind.Error() }
+
+func (e *DatabaseError) Unwrap() []error { return []error{e.Kind, e.Cause} }
+
+// Barang is a row of barang. The nama_* fields are only filled by queries
+// that join the corresponding lookup table.
+type Barang struct {
+	IDBarang     int64
+	NamaBarang   string
+	FotoBarang   string
+	Harga        int32
+	IDKategori   int64
+	NamaKategori string
+	IDJenis      int64
+	NamaJenis    string
+	IDMaterial   int64
+	NamaMaterial string
+}
+
+// NewBarang holds the columns of a barang to insert.
+type NewBarang struct {
+	NamaBarang string
+	FotoBarang string
+	Harga      int32
+	IDKategori int64
+	IDJenis    int64
+	IDMaterial int64
+}
+
+// BarangUpdate lists the columns to change; nil fields are left as they are.
+type BarangUpdate struct {
+	NamaBarang *string
+	FotoBarang *string
+	Harga      *int32
+	IDKategori *int64
+	IDJenis    *int64
+	IDMaterial *int64
+}
+
+// Batch is a ref_barang lot together with its barang. As with Barang, fields
+// a query does not select are left zero.
+type Batch struct {
+	IDRefBarang int64
+	NoBatch     string
+	Stok        int32
+	Expired     string // YYYY-MM-DD
+	Barang
+}
+
+// NewBatch holds the columns of a ref_barang lot to insert.
+type NewBatch struct {
+	IDBarang int64
+	Stok     int32
+	Expired  string // YYYY-MM-DD
+	NoBatch  string
+}
+
+// BatchFailure is a NewBatch that could not be inserted. Index is its
+// position in the slice passed to CreateBatches.
+type BatchFailure struct {
+	Index int
+	Err   error
+}
+
+// BatchKey addresses one lot, by IDRefBarang or, when set, by NoBatch. A
+// NoBatch shared by several lots fails with ErrAmbiguous rather than picking
+// one of them.
+type BatchKey struct {
+	IDRefBarang int64
+	NoBatch     string
+}
+
+// BatchUpdate lists the columns to change; nil fields are left as they are.
+type BatchUpdate struct {
+	Stok    *int32
+	Expired *string // YYYY-MM-DD
+}
+
+// Lookup selects one of the kategori, jenis and material tables.
+type Lookup int
+
+const (
+	Kategori Lookup = iota
+	Jenis
+	Material
+)
+
+func (l Lookup) String() string {
+	switch l {
+	case Kategori:
+		return "kategori"
+	case Jenis:
+		return "jenis"
+	case Material:
+		return "material"
+	}
+	return "unknown"
+}
+
+// LookupRow is a row of one of the lookup tables.
+type LookupRow struct {
+	ID   int64
+	Nama string
+}
+
+// Cursor is the key of the last row of the previous page. Value holds the
+// sort column of that row when a list is not sorted by id alone.
+type Cursor struct {
+	Value string
+	ID    int64
+}
+
+// Page selects the rows after After (from the start when nil), at most Limit
+// of them.
+type Page struct {
+	After *Cursor
+	Limit int
+}
+
+// ItemFilter narrows ListItems. Zero fields do not filter.
+type ItemFilter struct {
+	IDKategori   int64
+	IDJenis      int64
+	IDMaterial   int64
+	HargaMin     *int32
+	HargaMax     *int32
+	NamaContains string // case-insensitive
+	BatchPrefix  string
+}
+
+// Sort fields accepted by ListItems.
+const (
+	SortByID         = ""
+	SortByHarga      = "harga"
+	SortByNamaBarang = "nama_barang"
+	SortByNoBatch    = "no_batch"
+)
+
+// ItemSort orders ListItems. nama_barang and no_batch sort
+// case-insensitively; ties are always broken by IDRefBarang.
+type ItemSort struct {
+	Field string
+	Desc  bool
+}
+
+// ExpiringQuery selects lots expiring between Start and End inclusive.
+type ExpiringQuery struct {
+	Start      string // YYYY-MM-DD
+	End        string // YYYY-MM-DD
+	IDKategori int64
+}
+
+// BarangRepository stores the barang master table.
+type BarangRepository interface {
+	// CreateBarang and UpdateBarang fail with ErrInvalidReference when the
+	// kategori, jenis or material does not exist, also when it is deleted
+	// while the barang is being written.
+	CreateBarang(ctx context.Context, barang NewBarang) (int64, error)
+	// GetBarang returns the barang with all three lookup names.
+	GetBarang(ctx context.Context, idBarang int64) (Barang, error)
+	UpdateBarang(ctx context.Context, idBarang int64, update BarangUpdate) (Barang, error)
+	// DeleteBarang fails with ErrReferenced while a lot still uses the barang.
+	DeleteBarang(ctx context.Context, idBarang int64) error
+	// ListBarangByLookup returns barang with the name from one lookup table,
+	// ordered by IDBarang.
+	ListBarangByLookup(ctx context.Context, lookup Lookup, page Page) ([]Barang, int32, error)
+	// UpdateHargaByBatch sets harga on the barang of every lot with noBatch,
+	// or returns ErrNotFound when no lot has it.
+	UpdateHargaByBatch(ctx context.Context, noBatch string, harga int32) error
+}
+
+// BatchRepository stores the ref_barang lots.
+type BatchRepository interface {
+	// ListItems returns lots with barang, kategori and jenis names, the
+	// ReadAll listing. The int32 is the total matching the filter.
+	ListItems(ctx context.Context, filter ItemFilter, sort ItemSort, page Page) ([]Batch, int32, error)
+	EachItem(ctx context.Context, fn func(Batch) error) error
+	ListBatches(ctx context.Context, page Page) ([]Batch, int32, error)
+	// ListExpired returns lots with Expired before asOf; ListNotExpired the
+	// others. A lot is still good on its expiry date and counts as expired
+	// from the day after. Both are ordered by IDRefBarang.
+	ListExpired(ctx context.Context, asOf string, page Page) ([]Batch, int32, error)
+	ListNotExpired(ctx context.Context, asOf string, page Page) ([]Batch, int32, error)
+	EachExpired(ctx context.Context, asOf string, fn func(Batch) error) error
+	EachNotExpired(ctx context.Context, asOf string, fn func(Batch) error) error
+	// ListExpiring is ordered by Expired, then IDRefBarang; the cursor Value
+	// is the Expired of the last row.
+	ListExpiring(ctx context.Context, query ExpiringQuery, page Page) ([]Batch, int32, error)
+
+	GetBatch(ctx context.Context, key BatchKey) (Batch, error)
+	UpdateBatch(ctx context.Context, key BatchKey, update BatchUpdate) (Batch, error)
+	DeleteBatch(ctx context.Context, key BatchKey) error
+	// CreateBatches inserts the lots in one transaction. Only a lot the
+	// database rejects on its own becomes a BatchFailure: one whose barang
+	// does not exist fails with ErrInvalidReference, others with
+	// ErrDuplicate or ErrInvalidValue. Unless bestEffort is set, any failure
+	// rolls back every row. Any other error, e.g. ErrUnavailable on a
+	// deadlock, rolls back every row and is returned as the error.
+	CreateBatches(ctx context.Context, batches []NewBatch, bestEffort bool) ([]BatchFailure, error)
+}
+
+// ReferensiRepository stores the kategori, jenis and material lookup tables.
+type ReferensiRepository interface {
+	ListLookup(ctx context.Context, lookup Lookup) ([]LookupRow, error)
+	CreateLookup(ctx context.Context, lookup Lookup, nama string) (LookupRow, error)
+	RenameLookup(ctx context.Context, lookup Lookup, id int64, nama string) (LookupRow, error)
+	// DeleteLookup fails with ErrReferenced while a barang still uses the row.
+	DeleteLookup(ctx context.Context, lookup Lookup, id int64) error
+}
+
+// Store is a complete storage backend.
+type Store interface {
+	BarangRepository
+	BatchRepository
+	ReferensiRepository
+	// Ping reports whether the backend can currently serve requests.
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,494 @@
+// storage/storagetest/storagetest.go
+
+// Package storagetest checks that a storage backend keeps the storage.Store
+// contract. Every backend runs the same suite from its own tests, so memstore,
+// SQLite, MySQL and PostgreSQL answer every query alike.
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"grpc_crud/storage"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// Run runs the suite. newStore must return an empty store on every call; the
+// tests only rely on the ids the store hands out, never on their values.
+func Run(t *testing.T, newStore func(t *testing.T) storage.Store) {
+	for _, test := range []struct {
+		name string
+		fn   func(t *testing.T, store storage.Store)
+	}{
+		{"Lookups", testLookups},
+		{"BarangJoins", testBarangJoins},
+		{"Referenced", testReferenced},
+		{"ItemPages", testItemPages},
+		{"ItemStringSort", testItemStringSort},
+		{"ItemFilter", testItemFilter},
+		{"ExpiryBoundary", testExpiryBoundary},
+		{"CreateBatches", testCreateBatches},
+		{"BatchKeys", testBatchKeys},
+		{"UpdateHargaByBatch", testUpdateHargaByBatch},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			test.fn(t, newStore(t))
+		})
+	}
+}
+
+var ctx = context.Background()
+
+func check(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func wantErr(t *testing.T, err, target error) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Fatalf("got error %v, want %v", err, target)
+	}
+}
+
+// fixture is a small inventory: three barang of different harga, all in the
+// first kategori, jenis and material, plus an unused second kategori.
+type fixture struct {
+	kategori, otherKategori, jenis, material int64
+	// barang holds the ids of Teh (harga 3000), Kopi (1000) and Susu (2000).
+	barang []int64
+}
+
+func newFixture(t *testing.T, store storage.Store) fixture {
+	t.Helper()
+	var f fixture
+	for _, lookup := range []struct {
+		lookup storage.Lookup
+		nama   string
+		id     *int64
+	}{
+		{storage.Kategori, "Minuman", &f.kategori},
+		{storage.Kategori, "Makanan", &f.otherKategori},
+		{storage.Jenis, "Botol", &f.jenis},
+		{storage.Material, "Kaca", &f.material},
+	} {
+		row, err := store.CreateLookup(ctx, lookup.lookup, lookup.nama)
+		check(t, err)
+		*lookup.id = row.ID
+	}
+
+	for _, barang := range []struct {
+		nama  string
+		harga int32
+	}{{"Teh", 3000}, {"Kopi", 1000}, {"Susu", 2000}} {
+		id, err := store.CreateBarang(ctx, storage.NewBarang{
+			NamaBarang: barang.nama,
+			FotoBarang: barang.nama + ".jpg",
+			Harga:      barang.harga,
+			IDKategori: f.kategori,
+			IDJenis:    f.jenis,
+			IDMaterial: f.material,
+		})
+		check(t, err)
+		f.barang = append(f.barang, id)
+	}
+	return f
+}
+
+// createBatches inserts the lots all-or-nothing and returns their
+// id_ref_barang by no_batch.
+func createBatches(t *testing.T, store storage.Store, batches ...storage.NewBatch) map[string]int64 {
+	t.Helper()
+	failures, err := store.CreateBatches(ctx, batches, false)
+	check(t, err)
+	if len(failures) > 0 {
+		t.Fatalf("CreateBatches failed rows: %v", failures[0].Err)
+	}
+
+	rows, _, err := store.ListBatches(ctx, storage.Page{Limit: 1000})
+	check(t, err)
+	ids := map[string]int64{}
+	for _, row := range rows {
+		ids[row.NoBatch] = row.IDRefBarang
+	}
+	return ids
+}
+
+func testLookups(t *testing.T, store storage.Store) {
+	first, err := store.CreateLookup(ctx, storage.Jenis, "Botol")
+	check(t, err)
+	second, err := store.CreateLookup(ctx, storage.Jenis, "Sachet")
+	check(t, err)
+
+	renamed, err := store.RenameLookup(ctx, storage.Jenis, first.ID, "Kaleng")
+	check(t, err)
+	if renamed != (storage.LookupRow{ID: first.ID, Nama: "Kaleng"}) {
+		t.Errorf("RenameLookup = %+v", renamed)
+	}
+
+	rows, err := store.ListLookup(ctx, storage.Jenis)
+	check(t, err)
+	want := []storage.LookupRow{{ID: first.ID, Nama: "Kaleng"}, second}
+	if !slices.Equal(rows, want) {
+		t.Errorf("ListLookup = %+v, want %+v", rows, want)
+	}
+	if rows, err := store.ListLookup(ctx, storage.Kategori); err != nil || len(rows) != 0 {
+		t.Errorf("ListLookup(kategori) = %+v, %v, want no rows", rows, err)
+	}
+
+	check(t, store.DeleteLookup(ctx, storage.Jenis, second.ID))
+	wantErr(t, store.DeleteLookup(ctx, storage.Jenis, second.ID), storage.ErrNotFound)
+	_, err = store.RenameLookup(ctx, storage.Jenis, second.ID, "Sachet")
+	wantErr(t, err, storage.ErrNotFound)
+}
+
+func testBarangJoins(t *testing.T, store storage.Store) {
+	f := newFixture(t, store)
+
+	barang, err := store.GetBarang(ctx, f.barang[0])
+	check(t, err)
+	want := storage.Barang{
+		IDBarang:     f.barang[0],
+		NamaBarang:   "Teh",
+		FotoBarang:   "Teh.jpg",
+		Harga:        3000,
+		IDKategori:   f.kategori,
+		NamaKategori: "Minuman",
+		IDJenis:      f.jenis,
+		NamaJenis:    "Botol",
+		IDMaterial:   f.material,
+		NamaMaterial: "Kaca",
+	}
+	if barang != want {
+		t.Errorf("GetBarang = %+v, want %+v", barang, want)
+	}
+
+	rows, total, err := store.ListBarangByLookup(ctx, storage.Jenis, storage.Page{Limit: 2})
+	check(t, err)
+	if total != 3 || len(rows) != 2 || rows[0].IDBarang != f.barang[0] || rows[1].NamaJenis != "Botol" {
+		t.Errorf("ListBarangByLookup = %+v, %d", rows, total)
+	}
+	rows, _, err = store.ListBarangByLookup(ctx, storage.Jenis, storage.Page{After: &storage.Cursor{ID: rows[1].IDBarang}, Limit: 2})
+	check(t, err)
+	if len(rows) != 1 || rows[0].IDBarang != f.barang[2] {
+		t.Errorf("second ListBarangByLookup page = %+v", rows)
+	}
+
+	_, err = store.CreateBarang(ctx, storage.NewBarang{NamaBarang: "Roti", IDKategori: f.kategori + 100, IDJenis: f.jenis, IDMaterial: f.material})
+	wantErr(t, err, storage.ErrInvalidReference)
+	var refErr *storage.ReferenceError
+	if !errors.As(err, &refErr) || *refErr != (storage.ReferenceError{Column: "id_kategori", ID: f.kategori + 100}) {
+		t.Errorf("CreateBarang error = %#v, want a ReferenceError for id_kategori", err)
+	}
+
+	harga := int32(3500)
+	badJenis := f.jenis + 100
+	_, err = store.UpdateBarang(ctx, f.barang[0], storage.BarangUpdate{Harga: &harga, IDJenis: &badJenis})
+	wantErr(t, err, storage.ErrInvalidReference)
+	if barang, err := store.GetBarang(ctx, f.barang[0]); err != nil || barang != want {
+		t.Errorf("barang changed by a failed update: %+v, %v", barang, err)
+	}
+
+	barang, err = store.UpdateBarang(ctx, f.barang[0], storage.BarangUpdate{Harga: &harga, IDKategori: &f.otherKategori})
+	check(t, err)
+	if barang.Harga != 3500 || barang.NamaKategori != "Makanan" || barang.NamaBarang != "Teh" {
+		t.Errorf("UpdateBarang = %+v", barang)
+	}
+
+	_, err = store.GetBarang(ctx, f.barang[2]+100)
+	wantErr(t, err, storage.ErrNotFound)
+	_, err = store.UpdateBarang(ctx, f.barang[2]+100, storage.BarangUpdate{Harga: &harga})
+	wantErr(t, err, storage.ErrNotFound)
+}
+
+func testReferenced(t *testing.T, store storage.Store) {
+	f := newFixture(t, store)
+	createBatches(t, store, storage.NewBatch{IDBarang: f.barang[0], Stok: 1, Expired: "2024-05-10", NoBatch: "TEH-01"})
+
+	wantErr(t, store.DeleteLookup(ctx, storage.Kategori, f.kategori), storage.ErrReferenced)
+	wantErr(t, store.DeleteBarang(ctx, f.barang[0]), storage.ErrReferenced)
+
+	check(t, store.DeleteLookup(ctx, storage.Kategori, f.otherKategori))
+	check(t, store.DeleteBarang(ctx, f.barang[1]))
+	wantErr(t, store.DeleteBarang(ctx, f.barang[1]), storage.ErrNotFound)
+}
+
+// itemIDs reads every ListItems page of pageSize rows, building each cursor
+// from the last row the way the server does.
+func itemIDs(t *testing.T, store storage.Store, filter storage.ItemFilter, sort storage.ItemSort, pageSize int) []int64 {
+	t.Helper()
+	var ids []int64
+	page := storage.Page{Limit: pageSize}
+	for {
+		rows, _, err := store.ListItems(ctx, filter, sort, page)
+		check(t, err)
+		for _, row := range rows {
+			ids = append(ids, row.IDRefBarang)
+		}
+		if len(rows) < pageSize {
+			return ids
+		}
+		last := rows[len(rows)-1]
+		page.After = &storage.Cursor{ID: last.IDRefBarang}
+		switch sort.Field {
+		case storage.SortByHarga:
+			page.After.Value = strconv.Itoa(int(last.Harga))
+		case storage.SortByNamaBarang:
+			page.After.Value = last.NamaBarang
+		case storage.SortByNoBatch:
+			page.After.Value = last.NoBatch
+		}
+	}
+}
+
+func testItemPages(t *testing.T, store storage.Store) {
+	f := newFixture(t, store)
+	teh, kopi, susu := f.barang[0], f.barang[1], f.barang[2]
+	ids := createBatches(t, store,
+		storage.NewBatch{IDBarang: teh, Stok: 1, Expired: "2024-05-10", NoBatch: "L1"},
+		storage.NewBatch{IDBarang: kopi, Stok: 2, Expired: "2024-05-10", NoBatch: "L2"},
+		storage.NewBatch{IDBarang: susu, Stok: 3, Expired: "2024-05-10", NoBatch: "L3"},
+		storage.NewBatch{IDBarang: teh, Stok: 4, Expired: "2024-05-10", NoBatch: "L4"},
+		storage.NewBatch{IDBarang: kopi, Stok: 5, Expired: "2024-05-10", NoBatch: "L5"},
+	)
+	lots := func(names ...string) []int64 {
+		var result []int64
+		for _, name := range names {
+			result = append(result, ids[name])
+		}
+		return result
+	}
+
+	rows, total, err := store.ListItems(ctx, storage.ItemFilter{}, storage.ItemSort{}, storage.Page{Limit: 1})
+	check(t, err)
+	want := storage.Batch{IDRefBarang: ids["L1"], NoBatch: "L1", Barang: storage.Barang{NamaBarang: "Teh", FotoBarang: "Teh.jpg", Harga: 3000, NamaKategori: "Minuman", NamaJenis: "Botol"}}
+	if total != 5 || len(rows) != 1 || rows[0].IDRefBarang != want.IDRefBarang || rows[0].NoBatch != want.NoBatch ||
+		rows[0].NamaBarang != want.NamaBarang || rows[0].Harga != want.Harga || rows[0].NamaKategori != want.NamaKategori || rows[0].NamaJenis != want.NamaJenis {
+		t.Errorf("ListItems = %+v, %d, want [%+v], 5", rows, total, want)
+	}
+
+	for _, test := range []struct {
+		sort storage.ItemSort
+		want []int64
+	}{
+		{storage.ItemSort{}, lots("L1", "L2", "L3", "L4", "L5")},
+		{storage.ItemSort{Field: storage.SortByHarga}, lots("L2", "L5", "L3", "L1", "L4")},
+		{storage.ItemSort{Field: storage.SortByHarga, Desc: true}, lots("L4", "L1", "L3", "L5", "L2")},
+	} {
+		if got := itemIDs(t, store, storage.ItemFilter{}, test.sort, 2); !slices.Equal(got, test.want) {
+			t.Errorf("ListItems pages sorted by %+v = %v, want %v", test.sort, got, test.want)
+		}
+	}
+
+	// A lot inserted after the first page was read does not shift the next
+	// page.
+	first, _, err := store.ListItems(ctx, storage.ItemFilter{}, storage.ItemSort{}, storage.Page{Limit: 2})
+	check(t, err)
+	createBatches(t, store, storage.NewBatch{IDBarang: susu, Stok: 6, Expired: "2024-05-10", NoBatch: "L6"})
+	next, _, err := store.ListItems(ctx, storage.ItemFilter{}, storage.ItemSort{}, storage.Page{After: &storage.Cursor{ID: first[1].IDRefBarang}, Limit: 2})
+	check(t, err)
+	if len(next) != 2 || next[0].IDRefBarang != ids["L3"] || next[1].IDRefBarang != ids["L4"] {
+		t.Errorf("page after an insert = %+v, want L3 and L4", next)
+	}
+}
+
+// testItemStringSort checks that nama_barang and no_batch sort
+// case-insensitively, values differing only in case falling back to the id.
+func testItemStringSort(t *testing.T, store storage.Store) {
+	f := newFixture(t, store)
+	teh, kopi, susu := f.barang[0], f.barang[1], f.barang[2]
+	apel, err := store.CreateBarang(ctx, storage.NewBarang{NamaBarang: "apel", Harga: 500, IDKategori: f.kategori, IDJenis: f.jenis, IDMaterial: f.material})
+	check(t, err)
+	ids := createBatches(t, store,
+		storage.NewBatch{IDBarang: teh, Stok: 1, Expired: "2024-05-10", NoBatch: "b-1"},
+		storage.NewBatch{IDBarang: apel, Stok: 1, Expired: "2024-05-10", NoBatch: "A-2"},
+		storage.NewBatch{IDBarang: kopi, Stok: 1, Expired: "2024-05-10", NoBatch: "c-1"},
+		storage.NewBatch{IDBarang: susu, Stok: 1, Expired: "2024-05-10", NoBatch: "B-2"},
+		storage.NewBatch{IDBarang: apel, Stok: 1, Expired: "2024-05-10", NoBatch: "a-1"},
+		storage.NewBatch{IDBarang: teh, Stok: 1, Expired: "2024-05-10", NoBatch: "A-1"},
+	)
+	lots := func(names ...string) []int64 {
+		var result []int64
+		for _, name := range names {
+			result = append(result, ids[name])
+		}
+		return result
+	}
+
+	for _, test := range []struct {
+		sort storage.ItemSort
+		want []int64
+	}{
+		{storage.ItemSort{Field: storage.SortByNamaBarang}, lots("A-2", "a-1", "c-1", "B-2", "b-1", "A-1")},
+		{storage.ItemSort{Field: storage.SortByNamaBarang, Desc: true}, lots("A-1", "b-1", "B-2", "c-1", "a-1", "A-2")},
+		{storage.ItemSort{Field: storage.SortByNoBatch}, lots("a-1", "A-1", "A-2", "b-1", "B-2", "c-1")},
+		{storage.ItemSort{Field: storage.SortByNoBatch, Desc: true}, lots("c-1", "B-2", "b-1", "A-2", "A-1", "a-1")},
+	} {
+		if got := itemIDs(t, store, storage.ItemFilter{}, test.sort, 2); !slices.Equal(got, test.want) {
+			t.Errorf("ListItems pages sorted by %+v = %v, want %v", test.sort, got, test.want)
+		}
+	}
+}
+
+func testItemFilter(t *testing.T, store storage.Store) {
+	f := newFixture(t, store)
+	ids := createBatches(t, store,
+		storage.NewBatch{IDBarang: f.barang[0], Stok: 1, Expired: "2024-05-10", NoBatch: "AB-1"},
+		storage.NewBatch{IDBarang: f.barang[1], Stok: 1, Expired: "2024-05-10", NoBatch: "A_-2"},
+		storage.NewBatch{IDBarang: f.barang[2], Stok: 1, Expired: "2024-05-10", NoBatch: "ab%3"},
+	)
+
+	hargaMin, hargaMax := int32(1500), int32(2500)
+	for _, test := range []struct {
+		name   string
+		filter storage.ItemFilter
+		want   []int64
+	}{
+		{"kategori", storage.ItemFilter{IDKategori: f.kategori}, []int64{ids["AB-1"], ids["A_-2"], ids["ab%3"]}},
+		{"other kategori", storage.ItemFilter{IDKategori: f.otherKategori}, nil},
+		{"harga range", storage.ItemFilter{HargaMin: &hargaMin, HargaMax: &hargaMax}, []int64{ids["ab%3"]}},
+		{"nama is case-insensitive", storage.ItemFilter{NamaContains: "KO"}, []int64{ids["A_-2"]}},
+		{"prefix is case-insensitive", storage.ItemFilter{BatchPrefix: "ab"}, []int64{ids["AB-1"], ids["ab%3"]}},
+		{"wildcards are literal", storage.ItemFilter{BatchPrefix: "A_"}, []int64{ids["A_-2"]}},
+	} {
+		if got := itemIDs(t, store, test.filter, storage.ItemSort{}, 10); !slices.Equal(got, test.want) {
+			t.Errorf("%s: ListItems = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func testExpiryBoundary(t *testing.T, store storage.Store) {
+	f := newFixture(t, store)
+	ids := createBatches(t, store,
+		storage.NewBatch{IDBarang: f.barang[0], Stok: 1, Expired: "2024-05-11", NoBatch: "TOMORROW"},
+		storage.NewBatch{IDBarang: f.barang[0], Stok: 1, Expired: "2024-05-10", NoBatch: "TODAY"},
+		storage.NewBatch{IDBarang: f.barang[0], Stok: 1, Expired: "2024-05-09", NoBatch: "YESTERDAY"},
+	)
+	const asOf = "2024-05-10"
+
+	expired, total, err := store.ListExpired(ctx, asOf, storage.Page{Limit: 10})
+	check(t, err)
+	if total != 1 || len(expired) != 1 || expired[0].IDRefBarang != ids["YESTERDAY"] || expired[0].Expired != "2024-05-09" {
+		t.Errorf("ListExpired = %+v, %d, want YESTERDAY", expired, total)
+	}
+
+	notExpired, total, err := store.ListNotExpired(ctx, asOf, storage.Page{Limit: 10})
+	check(t, err)
+	if total != 2 || len(notExpired) != 2 || notExpired[0].IDRefBarang != ids["TOMORROW"] || notExpired[1].IDRefBarang != ids["TODAY"] {
+		t.Errorf("ListNotExpired = %+v, %d, want TOMORROW and TODAY", notExpired, total)
+	}
+
+	var streamed []int64
+	check(t, store.EachExpired(ctx, asOf, func(row storage.Batch) error {
+		streamed = append(streamed, row.IDRefBarang)
+		return nil
+	}))
+	if !slices.Equal(streamed, []int64{ids["YESTERDAY"]}) {
+		t.Errorf("EachExpired = %v, want YESTERDAY", streamed)
+	}
+
+	// ListExpiring is inclusive on both ends and ordered by expiry date.
+	expiring, total, err := store.ListExpiring(ctx, storage.ExpiringQuery{Start: "2024-05-10", End: "2024-05-11"}, storage.Page{Limit: 1})
+	check(t, err)
+	if total != 2 || len(expiring) != 1 || expiring[0].IDRefBarang != ids["TODAY"] || expiring[0].NamaKategori != "Minuman" {
+		t.Fatalf("ListExpiring = %+v, %d, want TODAY", expiring, total)
+	}
+	expiring, _, err = store.ListExpiring(ctx, storage.ExpiringQuery{Start: "2024-05-10", End: "2024-05-11"}, storage.Page{
+		After: &storage.Cursor{Value: expiring[0].Expired, ID: expiring[0].IDRefBarang},
+		Limit: 1,
+	})
+	check(t, err)
+	if len(expiring) != 1 || expiring[0].IDRefBarang != ids["TOMORROW"] {
+		t.Errorf("second ListExpiring page = %+v, want TOMORROW", expiring)
+	}
+}
+
+func testCreateBatches(t *testing.T, store storage.Store) {
+	f := newFixture(t, store)
+	batches := []storage.NewBatch{
+		{IDBarang: f.barang[0], Stok: 1, Expired: "2024-05-10", NoBatch: "OK-1"},
+		{IDBarang: f.barang[2] + 100, Stok: 1, Expired: "2024-05-10", NoBatch: "BAD"},
+		{IDBarang: f.barang[1], Stok: 1, Expired: "2024-05-10", NoBatch: "OK-2"},
+	}
+
+	failures, err := store.CreateBatches(ctx, batches, false)
+	check(t, err)
+	if len(failures) != 1 || failures[0].Index != 1 {
+		t.Fatalf("CreateBatches failures = %+v, want row 1", failures)
+	}
+	wantErr(t, failures[0].Err, storage.ErrInvalidReference)
+	if _, total, err := store.ListBatches(ctx, storage.Page{Limit: 10}); err != nil || total != 0 {
+		t.Errorf("all-or-nothing CreateBatches left %d rows, %v", total, err)
+	}
+
+	failures, err = store.CreateBatches(ctx, batches, true)
+	check(t, err)
+	if len(failures) != 1 || failures[0].Index != 1 {
+		t.Fatalf("best-effort CreateBatches failures = %+v, want row 1", failures)
+	}
+	rows, total, err := store.ListBatches(ctx, storage.Page{Limit: 10})
+	check(t, err)
+	if total != 2 || len(rows) != 2 || rows[0].NoBatch != "OK-1" || rows[1].NoBatch != "OK-2" {
+		t.Errorf("best-effort CreateBatches inserted %+v", rows)
+	}
+}
+
+func testBatchKeys(t *testing.T, store storage.Store) {
+	f := newFixture(t, store)
+	ids := createBatches(t, store,
+		storage.NewBatch{IDBarang: f.barang[0], Stok: 10, Expired: "2024-05-10", NoBatch: "ONE"},
+		storage.NewBatch{IDBarang: f.barang[0], Stok: 1, Expired: "2024-05-10", NoBatch: "SHARED"},
+		storage.NewBatch{IDBarang: f.barang[1], Stok: 1, Expired: "2024-05-10", NoBatch: "SHARED"},
+	)
+
+	batch, err := store.GetBatch(ctx, storage.BatchKey{NoBatch: "ONE"})
+	check(t, err)
+	if batch.IDRefBarang != ids["ONE"] || batch.IDBarang != f.barang[0] || batch.NamaBarang != "Teh" || batch.Stok != 10 || batch.Expired != "2024-05-10" {
+		t.Errorf("GetBatch = %+v", batch)
+	}
+
+	stok, expired := int32(7), "2024-06-01"
+	batch, err = store.UpdateBatch(ctx, storage.BatchKey{IDRefBarang: ids["ONE"]}, storage.BatchUpdate{Stok: &stok, Expired: &expired})
+	check(t, err)
+	if batch.Stok != 7 || batch.Expired != "2024-06-01" || batch.NoBatch != "ONE" {
+		t.Errorf("UpdateBatch = %+v", batch)
+	}
+
+	shared := storage.BatchKey{NoBatch: "SHARED"}
+	_, err = store.GetBatch(ctx, shared)
+	wantErr(t, err, storage.ErrAmbiguous)
+	_, err = store.UpdateBatch(ctx, shared, storage.BatchUpdate{Stok: &stok})
+	wantErr(t, err, storage.ErrAmbiguous)
+	wantErr(t, store.DeleteBatch(ctx, shared), storage.ErrAmbiguous)
+
+	check(t, store.DeleteBatch(ctx, storage.BatchKey{NoBatch: "ONE"}))
+	for _, key := range []storage.BatchKey{{NoBatch: "ONE"}, {IDRefBarang: ids["ONE"]}} {
+		_, err = store.GetBatch(ctx, key)
+		wantErr(t, err, storage.ErrNotFound)
+		_, err = store.UpdateBatch(ctx, key, storage.BatchUpdate{Stok: &stok})
+		wantErr(t, err, storage.ErrNotFound)
+		wantErr(t, store.DeleteBatch(ctx, key), storage.ErrNotFound)
+	}
+}
+
+func testUpdateHargaByBatch(t *testing.T, store storage.Store) {
+	f := newFixture(t, store)
+	createBatches(t, store,
+		storage.NewBatch{IDBarang: f.barang[0], Stok: 1, Expired: "2024-05-10", NoBatch: "SHARED"},
+		storage.NewBatch{IDBarang: f.barang[1], Stok: 1, Expired: "2024-05-10", NoBatch: "SHARED"},
+	)
+
+	check(t, store.UpdateHargaByBatch(ctx, "SHARED", 9900))
+	for i, want := range []int32{9900, 9900, 2000} {
+		barang, err := store.GetBarang(ctx, f.barang[i])
+		check(t, err)
+		if barang.Harga != want {
+			t.Errorf("harga of %s = %d, want %d", barang.NamaBarang, barang.Harga, want)
+		}
+	}
+
+	wantErr(t, store.UpdateHargaByBatch(ctx, "MISSING", 1), storage.ErrNotFound)
+}